@@ -2,9 +2,9 @@ package api
 
 import (
 	"encoding/xml"
-	"errors"
 	"fmt"
 
+	"github.com/dnaeon/go-ucs/mo"
 	"github.com/dnaeon/go-ucs/version"
 )
 
@@ -34,9 +34,20 @@ func (b *BaseResponse) Error() string {
 	return fmt.Sprintf("%s: %s (code %s)", b.ErrorDescription, b.InvocationResult, b.ErrorCode)
 }
 
-// ToError creates a new error.Error from the error response fields.
+// ToError creates a new *Error from the error response fields.
 func (b *BaseResponse) ToError() error {
-	return errors.New(b.Error())
+	return newError("", b)
+}
+
+// response is implemented by all response types embedding a BaseResponse.
+type response interface {
+	base() *BaseResponse
+}
+
+// base returns the BaseResponse itself, so that responses embedding it
+// satisfy the response interface.
+func (b *BaseResponse) base() *BaseResponse {
+	return b
 }
 
 // AaaLoginRequest is the type which is sent during initial login
@@ -99,6 +110,11 @@ type AaaKeepAliveRequest struct {
 	Cookie  string   `xml:"cookie,attr"`
 }
 
+// setCookie sets the authentication cookie of the request.
+func (r *AaaKeepAliveRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
 // AaaKeepAliveResponse is the response type associated with a AaaKeepAliveRequest.
 type AaaKeepAliveResponse struct {
 	BaseResponse
@@ -115,6 +131,11 @@ type ConfigResolveDnRequest struct {
 	InHierarchical string   `xml:"inHierarchical,attr,omitempty"`
 }
 
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigResolveDnRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
 // ConfigResolveDnResponse is the type associated with a ConfigResolveDnRequest type.
 // Specific classes contained within OutConfig should be xml.Unmarshal'ed first.
 type ConfigResolveDnResponse struct {
@@ -148,6 +169,11 @@ type ConfigResolveDnsRequest struct {
 	InDns          []Dn     `xml:"inDns>dn"`
 }
 
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigResolveDnsRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
 // ConfigResolveDnsResponse is the response type associated with a ConfigResolveDnsRequest.
 // The managed objects within OutConfigs field should be xml.Unmarshal'ed.
 type ConfigResolveDnsResponse struct {
@@ -175,6 +201,11 @@ type ConfigResolveClassRequest struct {
 	InFilter       FilterAny `xml:"inFilter>any,omitempty"`
 }
 
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigResolveClassRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
 // ConfigResolveClassResponse is the type associated with a ConfigResolveClassRequest.
 // Specific classes contained within OutConfigs should be xml.Unmarshal'ed first.
 type ConfigResolveClassResponse struct {
@@ -207,6 +238,11 @@ type ConfigResolveClassesRequest struct {
 	InIds          []Id     `xml:"inIds>Id"`
 }
 
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigResolveClassesRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
 // ConfigResolveClassesResponse is the response type associated with a ConfigResolveClassesRequest.
 type ConfigResolveClassesResponse struct {
 	BaseResponse
@@ -226,6 +262,11 @@ type ConfigResolveChildrenRequest struct {
 	InFilter       FilterAny `xml:"inFilter>any,omitempty"`
 }
 
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigResolveChildrenRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
 // ConfigResolveChildrenResponse is the response type associated with a ConfigResolveChildrenRequest.
 type ConfigResolveChildrenResponse struct {
 	BaseResponse
@@ -233,6 +274,290 @@ type ConfigResolveChildrenResponse struct {
 	OutConfigs InnerXml `xml:"outConfigs"`
 }
 
+// ConfigResolveParentRequest type is used for constructing requests that retrieve
+// the parent managed object of a specified DN.
+type ConfigResolveParentRequest struct {
+	XMLName        xml.Name `xml:"configResolveParent"`
+	Cookie         string   `xml:"cookie,attr"`
+	Dn             string   `xml:"dn,attr"`
+	InHierarchical string   `xml:"inHierarchical,attr,omitempty"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigResolveParentRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// ConfigResolveParentResponse is the response type associated with a ConfigResolveParentRequest.
+// The parent managed object contained within OutConfig should be xml.Unmarshal'ed.
+type ConfigResolveParentResponse struct {
+	BaseResponse
+	XMLName   xml.Name `xml:"configResolveParent"`
+	Dn        string   `xml:"dn,attr"`
+	OutConfig InnerXml `xml:"outConfig"`
+}
+
+// ConfigScopeRequest type is used for constructing requests that retrieve
+// managed objects of a given class below a specified DN. A filter can be used
+// to reduce the number of managed objects being returned.
+type ConfigScopeRequest struct {
+	XMLName        xml.Name  `xml:"configScope"`
+	Cookie         string    `xml:"cookie,attr"`
+	Dn             string    `xml:"dn,attr"`
+	InClass        string    `xml:"inClass,attr"`
+	InHierarchical string    `xml:"inHierarchical,attr,omitempty"`
+	InRecursive    string    `xml:"inRecursive,attr,omitempty"`
+	InFilter       FilterAny `xml:"inFilter>any,omitempty"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigScopeRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// ConfigScopeResponse is the response type associated with a ConfigScopeRequest.
+// Specific classes contained within OutConfigs should be xml.Unmarshal'ed first.
+type ConfigScopeResponse struct {
+	BaseResponse
+	XMLName    xml.Name `xml:"configScope"`
+	Dn         string   `xml:"dn,attr"`
+	OutConfigs InnerXml `xml:"outConfigs"`
+}
+
+// ConfigFindDnsByClassIdRequest type is used for constructing requests that retrieve
+// the DNs of managed objects of a given class, without retrieving the managed objects.
+// A filter can be used to reduce the number of DNs being returned.
+type ConfigFindDnsByClassIdRequest struct {
+	XMLName  xml.Name  `xml:"configFindDnsByClassId"`
+	Cookie   string    `xml:"cookie,attr"`
+	ClassId  string    `xml:"classId,attr"`
+	InFilter FilterAny `xml:"inFilter>any,omitempty"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigFindDnsByClassIdRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// ConfigFindDnsByClassIdResponse is the response type associated with a ConfigFindDnsByClassIdRequest.
+type ConfigFindDnsByClassIdResponse struct {
+	BaseResponse
+	XMLName xml.Name `xml:"configFindDnsByClassId"`
+	ClassId string   `xml:"classId,attr"`
+	OutDns  []Dn     `xml:"outDns>dn"`
+}
+
+// InConfig contains the managed object sent to a configuration method.
+// The status attribute of the managed object, e.g. "created,modified" or "deleted",
+// specifies whether the managed object is created, modified or deleted.
+type InConfig struct {
+	Object mo.Any
+}
+
+// ConfigConfMoRequest type is used for constructing requests that create, modify
+// or delete a single managed object with the given DN.
+type ConfigConfMoRequest struct {
+	XMLName        xml.Name `xml:"configConfMo"`
+	Cookie         string   `xml:"cookie,attr"`
+	Dn             string   `xml:"dn,attr"`
+	InHierarchical string   `xml:"inHierarchical,attr,omitempty"`
+	InConfig       InConfig `xml:"inConfig"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigConfMoRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// ConfigConfMoResponse is the response type associated with a ConfigConfMoRequest.
+// The resulting managed object contained within OutConfig should be xml.Unmarshal'ed.
+type ConfigConfMoResponse struct {
+	BaseResponse
+	XMLName   xml.Name `xml:"configConfMo"`
+	Dn        string   `xml:"dn,attr"`
+	OutConfig InnerXml `xml:"outConfig"`
+}
+
+// Pair represents a managed object keyed by its DN, which is sent to or
+// returned by the configConfMos method.
+type Pair struct {
+	XMLName xml.Name `xml:"pair"`
+	Key     string   `xml:"key,attr"`
+	Object  mo.Any
+}
+
+// NewPair creates a new pair for the managed object with the given DN.
+func NewPair(dn string, object mo.Any) Pair {
+	pair := Pair{
+		Key:    dn,
+		Object: object,
+	}
+
+	return pair
+}
+
+// ConfigConfMosRequest type is used for constructing requests that create, modify
+// or delete multiple managed objects in a single transaction. The pairs are applied
+// in the given order and if any of them fails none of the changes are applied.
+type ConfigConfMosRequest struct {
+	XMLName        xml.Name `xml:"configConfMos"`
+	Cookie         string   `xml:"cookie,attr"`
+	InHierarchical string   `xml:"inHierarchical,attr,omitempty"`
+	InConfigs      []Pair   `xml:"inConfigs>pair"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *ConfigConfMosRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// OutPair represents a resulting managed object keyed by its DN as returned
+// by the configConfMos method.
+type OutPair struct {
+	Key   string `xml:"key,attr"`
+	Inner []byte `xml:",innerxml"`
+}
+
+// Decode unmarshals the resulting managed object into the given concrete type.
+func (p OutPair) Decode(out mo.Any) error {
+	return xml.Unmarshal(p.Inner, &out)
+}
+
+// ConfigConfMosResponse is the response type associated with a ConfigConfMosRequest.
+// The resulting managed objects are contained within OutConfigs in the same order
+// as the pairs of the request.
+type ConfigConfMosResponse struct {
+	BaseResponse
+	XMLName    xml.Name  `xml:"configConfMos"`
+	OutConfigs []OutPair `xml:"outConfigs>pair"`
+}
+
+// LsInstantiateTemplateRequest type is used for constructing requests that create
+// a service profile from the service profile template with the given DN.
+type LsInstantiateTemplateRequest struct {
+	XMLName           xml.Name `xml:"lsInstantiateTemplate"`
+	Cookie            string   `xml:"cookie,attr"`
+	Dn                string   `xml:"dn,attr"`
+	InTargetOrg       string   `xml:"inTargetOrg,attr"`
+	InServerName      string   `xml:"inServerName,attr"`
+	InErrorOnExisting string   `xml:"inErrorOnExisting,attr,omitempty"`
+	InHierarchical    string   `xml:"inHierarchical,attr,omitempty"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *LsInstantiateTemplateRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// LsInstantiateTemplateResponse is the response type associated with a LsInstantiateTemplateRequest.
+// The created service profile is contained within OutConfig.
+type LsInstantiateTemplateResponse struct {
+	BaseResponse
+	XMLName   xml.Name `xml:"lsInstantiateTemplate"`
+	Dn        string   `xml:"dn,attr"`
+	OutConfig InnerXml `xml:"outConfig"`
+}
+
+// LsInstantiateNTemplateRequest type is used for constructing requests that create a number
+// of service profiles from the service profile template with the given DN. The names of the
+// service profiles are made of the given prefix followed by a number.
+type LsInstantiateNTemplateRequest struct {
+	XMLName                   xml.Name `xml:"lsInstantiateNTemplate"`
+	Cookie                    string   `xml:"cookie,attr"`
+	Dn                        string   `xml:"dn,attr"`
+	InTargetOrg               string   `xml:"inTargetOrg,attr"`
+	InServerNamePrefixOrEmpty string   `xml:"inServerNamePrefixOrEmpty,attr"`
+	InNumberOf                int      `xml:"inNumberOf,attr"`
+	InHierarchical            string   `xml:"inHierarchical,attr,omitempty"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *LsInstantiateNTemplateRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// LsInstantiateNTemplateResponse is the response type associated with a LsInstantiateNTemplateRequest.
+// The created service profiles are contained within OutConfigs.
+type LsInstantiateNTemplateResponse struct {
+	BaseResponse
+	XMLName    xml.Name `xml:"lsInstantiateNTemplate"`
+	Dn         string   `xml:"dn,attr"`
+	OutConfigs InnerXml `xml:"outConfigs"`
+}
+
+// LsCloneRequest type is used for constructing requests that create a copy
+// of the service profile with the given DN.
+type LsCloneRequest struct {
+	XMLName        xml.Name `xml:"lsClone"`
+	Cookie         string   `xml:"cookie,attr"`
+	Dn             string   `xml:"dn,attr"`
+	InTargetOrg    string   `xml:"inTargetOrg,attr"`
+	InServerName   string   `xml:"inServerName,attr"`
+	InHierarchical string   `xml:"inHierarchical,attr,omitempty"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *LsCloneRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// LsCloneResponse is the response type associated with a LsCloneRequest.
+// The created service profile is contained within OutConfig.
+type LsCloneResponse struct {
+	BaseResponse
+	XMLName   xml.Name `xml:"lsClone"`
+	Dn        string   `xml:"dn,attr"`
+	OutConfig InnerXml `xml:"outConfig"`
+}
+
+// EventSubscribeRequest type is used for subscribing to the event notifications
+// of the remote API endpoint. The event notifications are sent in the body of the
+// response for as long as the subscription is active.
+type EventSubscribeRequest struct {
+	XMLName xml.Name `xml:"eventSubscribe"`
+	Cookie  string   `xml:"cookie,attr"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *EventSubscribeRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// EventUnsubscribeRequest type is used for cancelling the subscription to the
+// event notifications of the remote API endpoint.
+type EventUnsubscribeRequest struct {
+	XMLName xml.Name `xml:"eventUnsubscribe"`
+	Cookie  string   `xml:"cookie,attr"`
+}
+
+// setCookie sets the authentication cookie of the request.
+func (r *EventUnsubscribeRequest) setCookie(cookie string) {
+	r.Cookie = cookie
+}
+
+// EventUnsubscribeResponse is the response type associated with a EventUnsubscribeRequest.
+type EventUnsubscribeResponse struct {
+	BaseResponse
+	XMLName xml.Name `xml:"eventUnsubscribe"`
+}
+
+// ConfigMoChangeEvent represents an event notification sent by the remote API endpoint
+// when a managed object is created, modified or deleted. The changed managed object
+// is contained within InConfig.
+type ConfigMoChangeEvent struct {
+	XMLName  xml.Name `xml:"configMoChangeEvent"`
+	Cookie   string   `xml:"cookie,attr"`
+	InEid    int64    `xml:"inEid,attr"`
+	InConfig InnerXml `xml:"inConfig"`
+}
+
+// MethodVessel represents a batch of event notifications sent by the remote API endpoint.
+type MethodVessel struct {
+	XMLName   xml.Name              `xml:"methodVessel"`
+	Cookie    string                `xml:"cookie,attr"`
+	InStimuli []ConfigMoChangeEvent `xml:"inStimuli>configMoChangeEvent"`
+}
+
 // FilterAny represents any valid filter.
 type FilterAny interface{}
 
@@ -324,3 +649,48 @@ type FilterBetween struct {
 	FirstVault  string   `xml:"firstValue,attr"`
 	SecondValue string   `xml:"secondValue,attr"`
 }
+
+// eqFilter returns a filter matching managed objects of the given class with the given
+// property equal to any of the given values or nil if there are no values.
+func eqFilter(class, property string, values ...string) FilterAny {
+	var filters []FilterAny
+	for _, value := range values {
+		filter := FilterEq{
+			FilterProperty: FilterProperty{
+				Class:    class,
+				Property: property,
+				Value:    value,
+			},
+		}
+		filters = append(filters, filter)
+	}
+
+	switch len(filters) {
+	case 0:
+		return nil
+	case 1:
+		return filters[0]
+	}
+
+	return FilterOr{Filters: filters}
+}
+
+// andFilter returns a filter matching managed objects matched by all of the given
+// filters, which are not nil, or nil if all of the given filters are nil.
+func andFilter(filters ...FilterAny) FilterAny {
+	var and []FilterAny
+	for _, filter := range filters {
+		if filter != nil {
+			and = append(and, filter)
+		}
+	}
+
+	switch len(and) {
+	case 0:
+		return nil
+	case 1:
+		return and[0]
+	}
+
+	return FilterAnd{Filters: and}
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func TestChassisInventory(t *testing.T) {
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveDn": func(req testRequest) string {
+			return `<configResolveDn dn="sys/chassis-1" response="yes"><outConfig>` +
+				`<equipmentChassis dn="sys/chassis-1" id="1" operability="operable">` +
+				`<equipmentIOCard rn="slot-1" id="1" side="left" switchId="A" operability="operable" thermal="ok" voltage="ok" serial="FCH1234ABCD">` +
+				`<portGroup rn="fabric">` +
+				`<etherSwitchIntFIo rn="port-1" portId="1" slotId="1" chassisId="1" peerDn="sys/switch-A/slot-1/switch-ether/port-1" operState="up"/>` +
+				`</portGroup>` +
+				`<portGroup rn="host">` +
+				`<etherServerIntFIo rn="port-1" portId="1" slotId="1" chassisId="1" operState="up"/>` +
+				`</portGroup>` +
+				`</equipmentIOCard>` +
+				`<equipmentPsu rn="psu-1" id="1" operability="inoperable" thermal="ok" voltage="lower-critical" serial="POG1234ABCD">` +
+				`<equipmentPsuInputStats rn="input-stats" current="2.5" power="562.5" voltage="225"/>` +
+				`</equipmentPsu>` +
+				`</equipmentChassis>` +
+				`</outConfig></configResolveDn>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	var chassis mo.EquipmentChassis
+	req := ConfigResolveDnRequest{Dn: "sys/chassis-1", InHierarchical: "true"}
+	if err := client.ConfigResolveDn(context.Background(), req, &chassis); err != nil {
+		t.Fatalf("Cannot resolve chassis: %s", err)
+	}
+
+	if len(chassis.IOCards) != 1 || len(chassis.IOCards[0].PortGroups) != 2 {
+		t.Fatalf("Got I/O modules %+v", chassis.IOCards)
+	}
+
+	groups := chassis.IOCards[0].PortGroups
+	if len(groups[0].BackplanePorts) != 1 || groups[0].BackplanePorts[0].PeerDn != "sys/switch-A/slot-1/switch-ether/port-1" {
+		t.Fatalf("Got backplane ports %+v", groups[0].BackplanePorts)
+	}
+
+	if len(groups[1].ServerPorts) != 1 {
+		t.Fatalf("Got server ports %+v", groups[1].ServerPorts)
+	}
+
+	if len(chassis.Psus) != 1 || chassis.Psus[0].Voltage != "lower-critical" || chassis.Psus[0].InputStats == nil {
+		t.Fatalf("Got PSUs %+v", chassis.Psus)
+	}
+
+	if chassis.Psus[0].InputStats.Power != 562.5 {
+		t.Fatalf("Got PSU input power %v, expect 562.5", chassis.Psus[0].InputStats.Power)
+	}
+}
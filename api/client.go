@@ -4,9 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/xml"
-	"io/ioutil"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"sync"
 	"time"
 
 	"github.com/dnaeon/go-ucs/mo"
@@ -43,20 +47,36 @@ type Config struct {
 	// Password to use when authenticating to the remote endpoint.
 	Password string
 
+	// MaxResponseSize is the maximum size in bytes of a response body read
+	// from the remote endpoint. If zero, a maximum size of 256 MiB is used.
+	MaxResponseSize int64
+
 	// RateLimit is used for limiting the number of requests per second
 	// against the remote Cisco UCS API endpoint using a token bucket.
 	RateLimit *RateLimit
+
+	// Retry is used for retrying requests, which failed because of a
+	// transient error. If nil, requests are attempted only once.
+	Retry *RetryPolicy
+
+	// Session enables the session manager, which keeps the authentication
+	// cookie of the Client alive. If nil, callers are responsible for
+	// logging in and refreshing the session themselves.
+	Session *Session
 }
 
 // Client is used for interfacing with the remote Cisco UCS API endpoint.
+// A Client is safe for concurrent use by multiple goroutines.
 type Client struct {
-	config  *Config
-	apiUrl  *url.URL
-	limiter *rate.Limiter
-
-	// Cookie is the authentication cookie currently in use.
-	// It's value is set by the AaaLogin and AaaRefresh methods.
-	Cookie string
+	config      *Config
+	apiUrl      *url.URL
+	limiter     *rate.Limiter
+	retryPolicy *RetryPolicy
+	session     *sessionManager
+
+	// mu guards the authentication cookie currently in use.
+	mu     sync.RWMutex
+	cookie string
 }
 
 // NewClient creates a new API client from the given config.
@@ -81,10 +101,22 @@ func NewClient(config Config) (*Client, error) {
 		limiter = rate.NewLimiter(rps, config.RateLimit.Burst)
 	}
 
+	var retryPolicy *RetryPolicy
+	if config.Retry != nil {
+		retryPolicy = newRetryPolicy(*config.Retry)
+	}
+
+	var session *sessionManager
+	if config.Session != nil {
+		session = newSessionManager(*config.Session)
+	}
+
 	client := &Client{
-		config:  &config,
-		apiUrl:  baseUrl.ResolveReference(apiUrl),
-		limiter: limiter,
+		config:      &config,
+		apiUrl:      baseUrl.ResolveReference(apiUrl),
+		limiter:     limiter,
+		retryPolicy: retryPolicy,
+		session:     session,
 	}
 
 	return client, nil
@@ -95,6 +127,18 @@ func (c *Client) Hostname() string {
 	return c.apiUrl.Host
 }
 
+// Cookie returns the authentication cookie currently in use.
+// It's value is set by the AaaLogin and AaaRefresh methods.
+//
+// Cookie replaces the exported Cookie field of previous releases,
+// which could not be read safely while the session is refreshed.
+func (c *Client) Cookie() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.cookie
+}
+
 // AaaLogin performs the initial authentication to the remote Cisco UCS API endpoint.
 func (c *Client) AaaLogin(ctx context.Context) (*AaaLoginResponse, error) {
 	req := AaaLoginRequest{
@@ -107,61 +151,60 @@ func (c *Client) AaaLogin(ctx context.Context) (*AaaLoginResponse, error) {
 		return nil, err
 	}
 
-	if resp.IsError() {
-		return nil, resp.ToError()
-	}
-
 	// Set authentication cookie for future re-use when needed.
-	c.Cookie = resp.OutCookie
+	c.setCookie(resp.OutCookie, resp.OutRefreshPeriod)
 
 	return &resp, nil
 }
 
 // AaaRefresh refreshes the current session by requesting a new authentication cookie.
 func (c *Client) AaaRefresh(ctx context.Context) (*AaaRefreshResponse, error) {
+	return c.aaaRefresh(ctx, c.Request)
+}
+
+// aaaRefresh refreshes the current session sending the request using the given
+// function, e.g. RequestNow in order to bypass the rate limiter.
+func (c *Client) aaaRefresh(ctx context.Context, request func(ctx context.Context, in, out interface{}) error) (*AaaRefreshResponse, error) {
 	req := AaaRefreshRequest{
 		InName:     c.config.Username,
 		InPassword: c.config.Password,
-		InCookie:   c.Cookie,
+		InCookie:   c.Cookie(),
 	}
 
 	var resp AaaRefreshResponse
-	if err := c.Request(ctx, req, &resp); err != nil {
+	if err := request(ctx, req, &resp); err != nil {
 		return nil, err
 	}
 
-	if resp.IsError() {
-		return nil, resp.ToError()
-	}
-
 	// Set new authentication cookie
-	c.Cookie = resp.OutCookie
+	c.setCookie(resp.OutCookie, resp.OutRefreshPeriod)
 
 	return &resp, nil
 }
 
 // AaaKeepAlive sends a request to keep the current session active using the same cookie.
 func (c *Client) AaaKeepAlive(ctx context.Context) (*AaaKeepAliveResponse, error) {
-	req := AaaKeepAliveRequest{
-		Cookie: c.Cookie,
-	}
+	var req AaaKeepAliveRequest
 
 	var resp AaaKeepAliveResponse
-	if err := c.Request(ctx, req, &resp); err != nil {
+	if err := c.Request(ctx, &req, &resp); err != nil {
 		return nil, err
 	}
 
-	if resp.IsError() {
-		return nil, resp.ToError()
-	}
-
 	return &resp, nil
 }
 
 // AaaLogout invalidates the current client session.
 func (c *Client) AaaLogout(ctx context.Context) (*AaaLogoutResponse, error) {
+	// Hold the session manager's lock, so that a refresh running
+	// concurrently cannot renew the session we are logging out of.
+	if c.session != nil {
+		c.session.loginMu.Lock()
+		defer c.session.loginMu.Unlock()
+	}
+
 	req := AaaLogoutRequest{
-		InCookie: c.Cookie,
+		InCookie: c.Cookie(),
 	}
 
 	var resp AaaLogoutResponse
@@ -169,16 +212,53 @@ func (c *Client) AaaLogout(ctx context.Context) (*AaaLogoutResponse, error) {
 		return nil, err
 	}
 
-	if resp.IsError() {
-		return nil, resp.ToError()
+	c.setCookie("", 0)
+
+	return &resp, nil
+}
+
+// Close stops the background refresh of the session, if the session manager is
+// enabled, so that a Client can be released without logging out. Requests sent
+// after Close still login when needed, but the session is no longer refreshed.
+func (c *Client) Close() error {
+	if c.session != nil {
+		c.session.stop()
 	}
 
-	c.Cookie = ""
+	return nil
+}
 
-	return &resp, nil
+// setCookie sets the authentication cookie currently in use and lets the
+// session manager, if enabled, schedule a refresh ahead of the given
+// refresh period in seconds.
+func (c *Client) setCookie(cookie string, refreshPeriod int) {
+	c.mu.Lock()
+	c.cookie = cookie
+	c.mu.Unlock()
+
+	if c.session != nil {
+		c.session.update(c, cookie, refreshPeriod)
+	}
+}
+
+// resetValue sets the value pointed to by v to its zero value.
+func resetValue(v interface{}) {
+	if r, ok := v.(resetter); ok {
+		r.reset()
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return
+	}
+
+	rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
 }
 
 // doRequest sends a request to the remote Cisco UCS API endpoint.
+// If the response indicates an error it is returned as an *Error, while
+// unexpected HTTP responses are returned as a *TransportError.
 func (c *Client) doRequest(ctx context.Context, in, out interface{}) error {
 	data, err := xmlMarshalWithSelfClosingTags(in)
 	if err != nil {
@@ -200,7 +280,29 @@ func (c *Client) doRequest(ctx context.Context, in, out interface{}) error {
 	}
 	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	if err := c.decodeResponse(resp, out); err != nil {
+		return err
+	}
+
+	// Responses carrying an error code are turned into an *Error.
+	if resp, ok := out.(response); ok && resp.base().IsError() {
+		return newError(methodName(in), resp.base())
+	}
+
+	return nil
+}
+
+// decodeResponse decodes the body of the given response into out.
+func (c *Client) decodeResponse(resp *http.Response, out interface{}) error {
+	if d, ok := out.(bodyDecoder); ok {
+		if err := checkResponse(resp); err != nil {
+			return err
+		}
+
+		return d.decodeBody(xml.NewDecoder(resp.Body))
+	}
+
+	body, err := c.readBody(resp)
 	if err != nil {
 		return err
 	}
@@ -209,37 +311,76 @@ func (c *Client) doRequest(ctx context.Context, in, out interface{}) error {
 }
 
 // Request sends a POST request to the remote Cisco UCS API endpoint.
+// Failed requests are retried according to the retry policy, if any.
 func (c *Client) Request(ctx context.Context, in, out interface{}) error {
-	// Rate limit requests if we are using a limiter
-	if c.limiter != nil {
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, c.config.RateLimit.Wait)
-		defer cancel()
-		if err := c.limiter.Wait(ctxWithTimeout); err != nil {
-			return err
+	return c.retry(ctx, in, out, func() error {
+		// Rate limit requests if we are using a limiter
+		if c.limiter != nil {
+			ctxWithTimeout, cancel := context.WithTimeout(ctx, c.config.RateLimit.Wait)
+			defer cancel()
+			if err := c.limiter.Wait(ctxWithTimeout); err != nil {
+				return err
+			}
 		}
-	}
 
-	return c.doRequest(ctx, in, out)
+		return c.send(ctx, in, out)
+	})
 }
 
 // RequestNow sends a POST request to the remote Cisco UCS API endpoint immediately.
 // This bypasses any rate limiter configuration that may be used and is
 // meant to be used for priority requests, e.g. refreshing a token, logging out, etc.
 func (c *Client) RequestNow(ctx context.Context, in, out interface{}) error {
-	return c.doRequest(ctx, in, out)
+	return c.retry(ctx, in, out, func() error {
+		return c.send(ctx, in, out)
+	})
+}
+
+// send sends a request to the remote Cisco UCS API endpoint. Requests carrying
+// an authentication cookie are sent using the cookie of the current session,
+// which is obtained from the session manager if enabled.
+func (c *Client) send(ctx context.Context, in, out interface{}) error {
+	r, ok := asCookieRequest(in)
+	if !ok {
+		return c.doRequest(ctx, in, out)
+	}
+
+	if c.session != nil {
+		return c.session.do(ctx, c, r, out)
+	}
+
+	r.setCookie(c.Cookie())
+
+	return c.doRequest(ctx, r, out)
+}
+
+// asCookieRequest returns the given request as a cookieRequest, if it carries an
+// authentication cookie. Requests passed by value are copied, so that the
+// cookie can be set without modifying the request of the caller.
+func asCookieRequest(in interface{}) (cookieRequest, bool) {
+	if r, ok := in.(cookieRequest); ok {
+		return r, true
+	}
+
+	v := reflect.ValueOf(in)
+	if !v.IsValid() || v.Kind() == reflect.Ptr {
+		return nil, false
+	}
+
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	r, ok := p.Interface().(cookieRequest)
+
+	return r, ok
 }
 
 // ConfigResolveDn retrieves a single managed object for a specified DN.
 func (c *Client) ConfigResolveDn(ctx context.Context, in ConfigResolveDnRequest, out mo.Any) error {
 	var resp ConfigResolveDnResponse
-	if err := c.Request(ctx, in, &resp); err != nil {
+	if err := c.Request(ctx, &in, &resp); err != nil {
 		return err
 	}
 
-	if resp.IsError() {
-		return resp.ToError()
-	}
-
 	// The requested managed object is contained within the inner XML document,
 	// which we need to unmarshal first into the given concrete type.
 	return xml.Unmarshal(resp.OutConfig.Inner, &out)
@@ -249,14 +390,10 @@ func (c *Client) ConfigResolveDn(ctx context.Context, in ConfigResolveDnRequest,
 // ConfigResolveDns retrieves managed objects for a specified list of DNs.
 func (c *Client) ConfigResolveDns(ctx context.Context, in ConfigResolveDnsRequest, out mo.Any) (*ConfigResolveDnsResponse, error) {
 	var resp ConfigResolveDnsResponse
-	if err := c.Request(ctx, in, &resp); err != nil {
+	if err := c.Request(ctx, &in, &resp); err != nil {
 		return nil, err
 	}
 
-	if resp.IsError() {
-		return nil, resp.ToError()
-	}
-
 	inner, err := xml.Marshal(resp.OutConfigs)
 	if err != nil {
 		return nil, err
@@ -272,14 +409,10 @@ func (c *Client) ConfigResolveDns(ctx context.Context, in ConfigResolveDnsReques
 // ConfigResolveClass retrieves managed objects of the specified class.
 func (c *Client) ConfigResolveClass(ctx context.Context, in ConfigResolveClassRequest, out mo.Any) error {
 	var resp ConfigResolveClassResponse
-	if err := c.Request(ctx, in, &resp); err != nil {
+	if err := c.Request(ctx, &in, &resp); err != nil {
 		return err
 	}
 
-	if resp.IsError() {
-		return resp.ToError()
-	}
-
 	inner, err := xml.Marshal(resp.OutConfigs)
 	if err != nil {
 		return err
@@ -293,14 +426,10 @@ func (c *Client) ConfigResolveClass(ctx context.Context, in ConfigResolveClassRe
 // ConfigResolveClasses retrieves managed objects from the specified list of classes.
 func (c *Client) ConfigResolveClasses(ctx context.Context, in ConfigResolveClassesRequest, out mo.Any) error {
 	var resp ConfigResolveClassesResponse
-	if err := c.Request(ctx, in, &resp); err != nil {
+	if err := c.Request(ctx, &in, &resp); err != nil {
 		return err
 	}
 
-	if resp.IsError() {
-		return resp.ToError()
-	}
-
 	inner, err := xml.Marshal(resp.OutConfigs)
 	if err != nil {
 		return err
@@ -315,12 +444,106 @@ func (c *Client) ConfigResolveClasses(ctx context.Context, in ConfigResolveClass
 func (c *Client) ConfigResolveChildren(ctx context.Context, in ConfigResolveChildrenRequest, out mo.Any) error {
 	var resp ConfigResolveChildrenResponse
 
-	if err := c.Request(ctx, in, &resp); err != nil {
+	if err := c.Request(ctx, &in, &resp); err != nil {
 		return err
 	}
 
-	if resp.IsError() {
-		return resp.ToError()
+	inner, err := xml.Marshal(resp.OutConfigs)
+	if err != nil {
+		return err
+	}
+
+	// The requested managed objects are contained within the inner XML document,
+	// which we need to unmarshal first into the given concrete type.
+	return xml.Unmarshal(inner, &out)
+}
+
+// ConfigResolveParent retrieves the parent managed object of a specified DN.
+func (c *Client) ConfigResolveParent(ctx context.Context, in ConfigResolveParentRequest, out mo.Any) error {
+	var resp ConfigResolveParentResponse
+	if err := c.Request(ctx, &in, &resp); err != nil {
+		return err
+	}
+
+	// The parent managed object is contained within the inner XML document,
+	// which we need to unmarshal first into the given concrete type.
+	return xml.Unmarshal(resp.OutConfig.Inner, &out)
+}
+
+// ResolveParent retrieves the parent managed object of the given managed object,
+// which is identified by its Dn field.
+func (c *Client) ResolveParent(ctx context.Context, obj mo.Any, out mo.Any) error {
+	dn, err := dnOf(obj)
+	if err != nil {
+		return err
+	}
+
+	req := ConfigResolveParentRequest{
+		Dn:             dn,
+		InHierarchical: "false",
+	}
+
+	return c.ConfigResolveParent(ctx, req, out)
+}
+
+// ResolveChildren retrieves the children of the given class of the given managed object,
+// which is identified by its Dn field.
+func (c *Client) ResolveChildren(ctx context.Context, obj mo.Any, classId string, out mo.Any) error {
+	dn, err := dnOf(obj)
+	if err != nil {
+		return err
+	}
+
+	req := ConfigResolveChildrenRequest{
+		ClassId:        classId,
+		InDn:           dn,
+		InHierarchical: "false",
+	}
+
+	return c.ConfigResolveChildren(ctx, req, out)
+}
+
+// dnOf returns the value of the Dn field of the given managed object.
+func dnOf(obj mo.Any) (string, error) {
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return "", ErrNoDn
+	}
+
+	field := v.FieldByName("Dn")
+	if !field.IsValid() || field.Kind() != reflect.String || field.String() == "" {
+		return "", ErrNoDn
+	}
+
+	return field.String(), nil
+}
+
+// resolveDn retrieves the managed object with the given DN without its children.
+// If the managed object does not exist an *Error matching ErrObjectNotFound is returned.
+func (c *Client) resolveDn(ctx context.Context, dn string, out mo.Any) error {
+	req := ConfigResolveDnRequest{
+		Dn:             dn,
+		InHierarchical: "false",
+	}
+
+	// The response does not contain a managed object, if it does not exist.
+	err := c.ConfigResolveDn(ctx, req, out)
+	if err == io.EOF {
+		err = &Error{
+			Method:      "configResolveDn",
+			Code:        ErrorCodeObjectNotFound,
+			Description: fmt.Sprintf("managed object %s not found", dn),
+		}
+	}
+
+	return err
+}
+
+// ConfigScope retrieves managed objects of the specified class below the specified DN.
+func (c *Client) ConfigScope(ctx context.Context, in ConfigScopeRequest, out mo.Any) error {
+	var resp ConfigScopeResponse
+	if err := c.Request(ctx, &in, &resp); err != nil {
+		return err
 	}
 
 	inner, err := xml.Marshal(resp.OutConfigs)
@@ -332,3 +555,153 @@ func (c *Client) ConfigResolveChildren(ctx context.Context, in ConfigResolveChil
 	// which we need to unmarshal first into the given concrete type.
 	return xml.Unmarshal(inner, &out)
 }
+
+// ConfigFindDnsByClassId retrieves the DNs of managed objects of the specified class.
+func (c *Client) ConfigFindDnsByClassId(ctx context.Context, in ConfigFindDnsByClassIdRequest) ([]Dn, error) {
+	var resp ConfigFindDnsByClassIdResponse
+	if err := c.Request(ctx, &in, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.OutDns, nil
+}
+
+// ConfigConfMo creates, modifies or deletes a single managed object with the specified DN.
+// The resulting managed object is unmarshaled into out, unless out is nil.
+func (c *Client) ConfigConfMo(ctx context.Context, in ConfigConfMoRequest, out mo.Any) error {
+	var resp ConfigConfMoResponse
+	if err := c.Request(ctx, &in, &resp); err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	// The resulting managed object is contained within the inner XML document,
+	// which we need to unmarshal first into the given concrete type.
+	return xml.Unmarshal(resp.OutConfig.Inner, &out)
+}
+
+// sessionErrorCodes contains the error codes, which are returned when a request
+// is rejected because of the session or the load of the remote endpoint rather
+// than because of the changes it contains.
+var sessionErrorCodes = map[string]bool{
+	ErrorCodeAuthenticationFailed: true,
+	ErrorCodeSessionExpired:       true,
+	ErrorCodeAuthorizationDenied:  true,
+	ErrorCodeThrottled:            true,
+}
+
+// ConfigConfMos creates, modifies or deletes multiple managed objects in a single transaction.
+// If the remote endpoint rejects any of the changes the whole transaction is rolled back
+// and a *RollbackError wrapping the *Error returned by the remote endpoint is returned.
+// Errors which are not caused by the changes, e.g. an expired session, are returned as is.
+func (c *Client) ConfigConfMos(ctx context.Context, in ConfigConfMosRequest) (*ConfigConfMosResponse, error) {
+	var resp ConfigConfMosResponse
+	if err := c.Request(ctx, &in, &resp); err != nil {
+		var apiErr *Error
+		if !errors.As(err, &apiErr) || sessionErrorCodes[apiErr.Code] {
+			return nil, err
+		}
+
+		dns := make([]string, 0, len(in.InConfigs))
+		for _, pair := range in.InConfigs {
+			dns = append(dns, pair.Key)
+		}
+
+		return nil, newRollbackError(apiErr, dns)
+	}
+
+	return &resp, nil
+}
+
+// LsInstantiateTemplate creates a service profile from a service profile template
+// and returns the DN of the created service profile.
+func (c *Client) LsInstantiateTemplate(ctx context.Context, in LsInstantiateTemplateRequest) (string, error) {
+	var resp LsInstantiateTemplateResponse
+	if err := c.Request(ctx, &in, &resp); err != nil {
+		return "", err
+	}
+
+	dns, err := createdDns(methodName(in), resp.OutConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return dns[0], nil
+}
+
+// LsInstantiateNTemplate creates a number of service profiles from a service profile
+// template and returns the DNs of the created service profiles.
+func (c *Client) LsInstantiateNTemplate(ctx context.Context, in LsInstantiateNTemplateRequest) ([]string, error) {
+	var resp LsInstantiateNTemplateResponse
+	if err := c.Request(ctx, &in, &resp); err != nil {
+		return nil, err
+	}
+
+	return createdDns(methodName(in), resp.OutConfigs)
+}
+
+// LsClone creates a copy of a service profile and returns the DN of the created service profile.
+func (c *Client) LsClone(ctx context.Context, in LsCloneRequest) (string, error) {
+	var resp LsCloneResponse
+	if err := c.Request(ctx, &in, &resp); err != nil {
+		return "", err
+	}
+
+	dns, err := createdDns(methodName(in), resp.OutConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return dns[0], nil
+}
+
+// createdDns returns the DNs of the managed objects created by the given method,
+// which are contained within the given inner XML document. If the remote endpoint
+// did not return any managed object or a managed object without a DN, an error
+// wrapping ErrNoObjectReturned is returned.
+func createdDns(method string, inner InnerXml) ([]string, error) {
+	dns, err := objectDns(inner)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dns) == 0 {
+		return nil, fmt.Errorf("%s: %w", method, ErrNoObjectReturned)
+	}
+
+	for _, dn := range dns {
+		if dn == "" {
+			return nil, fmt.Errorf("%s: %w", method, ErrNoObjectReturned)
+		}
+	}
+
+	return dns, nil
+}
+
+// objectDns returns the DNs of the managed objects contained within the given inner XML document.
+func objectDns(inner InnerXml) ([]string, error) {
+	data, err := xml.Marshal(inner)
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		Objects []struct {
+			Dn string `xml:"dn,attr"`
+		} `xml:",any"`
+	}
+
+	if err := xml.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+
+	dns := make([]string, 0, len(out.Objects))
+	for _, obj := range out.Objects {
+		dns = append(dns, obj.Dn)
+	}
+
+	return dns, nil
+}
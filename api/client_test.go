@@ -0,0 +1,530 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// resolveBlades retrieves the compute blades from the given client concurrently.
+func resolveBlades(t *testing.T, client *Client, workers int) {
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var out struct {
+				XMLName xml.Name
+				Blades  []mo.ComputeBlade `xml:"computeBlade"`
+			}
+
+			req := ConfigResolveClassRequest{
+				ClassId:        "computeBlade",
+				InHierarchical: "false",
+			}
+
+			if err := client.ConfigResolveClass(context.Background(), req, &out); err != nil {
+				t.Errorf("Cannot resolve class: %s", err)
+				return
+			}
+
+			if len(out.Blades) != 2 {
+				t.Errorf("Got %d blades, expect 2", len(out.Blades))
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestClientConcurrentRequests(t *testing.T) {
+	sessions := &fakeSessions{refreshPeriod: 600}
+	handlers := sessions.handlers()
+	handlers["aaaKeepAlive"] = func(req testRequest) string {
+		return `<aaaKeepAlive response="yes" cookie="` + req.Attrs["cookie"] + `"/>`
+	}
+
+	ts := newTestServer(t, handlers)
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	if _, err := client.AaaLogin(ctx); err != nil {
+		t.Fatalf("Cannot login: %s", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			if _, err := client.AaaKeepAlive(ctx); err != nil {
+				t.Errorf("Cannot keep session alive: %s", err)
+			}
+		}
+	}()
+
+	resolveBlades(t, client, 50)
+	<-done
+
+	if _, err := client.AaaLogout(ctx); err != nil {
+		t.Fatalf("Cannot logout: %s", err)
+	}
+
+	if cookie := client.Cookie(); cookie != "" {
+		t.Fatalf("Got cookie %q after logout, expect empty cookie", cookie)
+	}
+}
+
+func TestClientConcurrentRequestsWithRefresh(t *testing.T) {
+	sessions := &fakeSessions{refreshPeriod: 1}
+	ts := newTestServer(t, sessions.handlers())
+	defer ts.Close()
+
+	// Refresh the session every 100ms in the background.
+	client := ts.NewClient(t, Config{Session: &Session{RefreshMargin: 900 * time.Millisecond}})
+	ctx := context.Background()
+	defer client.AaaLogout(ctx)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := client.AaaRefresh(ctx); err != nil {
+					t.Errorf("Cannot refresh session: %s", err)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 5; i++ {
+		resolveBlades(t, client, 20)
+
+		// Expire all sessions, which makes all clients login again.
+		sessions.expire()
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	wg.Wait()
+}
+
+func TestConfigConfMo(t *testing.T) {
+	var body string
+	ts := newTestServer(t, map[string]testHandler{
+		"configConfMo": func(req testRequest) string {
+			body = string(req.Body)
+			return `<configConfMo dn="sys/svc-ext/dns-svc/dns-192.0.2.1" response="yes"><outConfig><commDnsProvider dn="sys/svc-ext/dns-svc/dns-192.0.2.1" name="192.0.2.1" status="created"/></outConfig></configConfMo>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	provider := mo.CommDnsProvider{
+		Dn:     "sys/svc-ext/dns-svc/dns-192.0.2.1",
+		Name:   "192.0.2.1",
+		Status: mo.StatusCreated,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:       provider.Dn,
+		InConfig: InConfig{Object: provider},
+	}
+
+	var out mo.CommDnsProvider
+	if err := client.ConfigConfMo(context.Background(), req, &out); err != nil {
+		t.Fatalf("Cannot configure managed object: %s", err)
+	}
+
+	expect := `<configConfMo cookie="" dn="sys/svc-ext/dns-svc/dns-192.0.2.1"><inConfig><commDnsProvider dn="sys/svc-ext/dns-svc/dns-192.0.2.1" name="192.0.2.1" status="created"/></inConfig></configConfMo>`
+	if body != expect {
+		t.Fatalf("Got request '%s', expect '%s'", body, expect)
+	}
+
+	if out.Dn != provider.Dn || out.Name != provider.Name {
+		t.Fatalf("Got managed object %+v", out)
+	}
+}
+
+func TestConfigConfMos(t *testing.T) {
+	var body, reply string
+	ts := newTestServer(t, map[string]testHandler{
+		"configConfMos": func(req testRequest) string {
+			body = string(req.Body)
+			if reply != "" {
+				return reply
+			}
+			return `<configConfMos response="yes"><outConfigs>` +
+				`<pair key="sys/svc-ext/dns-svc/dns-192.0.2.1"><commDnsProvider dn="sys/svc-ext/dns-svc/dns-192.0.2.1" name="192.0.2.1"/></pair>` +
+				`<pair key="sys/svc-ext/dns-svc/dns-192.0.2.2"><commDnsProvider dn="sys/svc-ext/dns-svc/dns-192.0.2.2" name="192.0.2.2"/></pair>` +
+				`</outConfigs></configConfMos>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	req := ConfigConfMosRequest{
+		InHierarchical: "false",
+		InConfigs: []Pair{
+			NewPair("sys/svc-ext/dns-svc/dns-192.0.2.1", mo.CommDnsProvider{Name: "192.0.2.1", Status: mo.StatusCreated}),
+			NewPair("sys/svc-ext/dns-svc/dns-192.0.2.2", mo.CommDnsProvider{Name: "192.0.2.2", Status: mo.StatusCreated}),
+		},
+	}
+
+	resp, err := client.ConfigConfMos(ctx, req)
+	if err != nil {
+		t.Fatalf("Cannot configure managed objects: %s", err)
+	}
+
+	expect := `<inConfigs><pair key="sys/svc-ext/dns-svc/dns-192.0.2.1"><commDnsProvider name="192.0.2.1" status="created"/></pair><pair key="sys/svc-ext/dns-svc/dns-192.0.2.2"><commDnsProvider name="192.0.2.2" status="created"/></pair></inConfigs>`
+	if !strings.Contains(body, expect) {
+		t.Fatalf("Got request '%s', expect it to contain '%s'", body, expect)
+	}
+
+	if len(resp.OutConfigs) != 2 {
+		t.Fatalf("Got %d pairs, expect 2", len(resp.OutConfigs))
+	}
+
+	for i, pair := range resp.OutConfigs {
+		var provider mo.CommDnsProvider
+		if err := pair.Decode(&provider); err != nil {
+			t.Fatalf("Cannot decode pair %s: %s", pair.Key, err)
+		}
+
+		if pair.Key != req.InConfigs[i].Key || provider.Dn != pair.Key {
+			t.Fatalf("Got pair %s with DN %s, expect %s", pair.Key, provider.Dn, req.InConfigs[i].Key)
+		}
+	}
+
+	reply = `<configConfMos response="yes" errorCode="103" invocationResult="unidentified-fail" errorDescr="can't create; object already exists."/>`
+	_, err = client.ConfigConfMos(ctx, req)
+
+	var rollbackErr *RollbackError
+	if !errors.As(err, &rollbackErr) {
+		t.Fatalf("Got error %v of type %T, expect *RollbackError", err, err)
+	}
+
+	if len(rollbackErr.Dns) != 2 || rollbackErr.Err.Code != "103" || rollbackErr.FailedDn != "" {
+		t.Fatalf("Got rollback error %+v", rollbackErr)
+	}
+
+	// The failing pair is reported, when the error refers to its DN.
+	reply = `<configConfMos response="yes" errorCode="103" invocationResult="unidentified-fail" errorDescr="sys/svc-ext/dns-svc/dns-192.0.2.2: can't create; object already exists."/>`
+	_, err = client.ConfigConfMos(ctx, req)
+	if !errors.As(err, &rollbackErr) || rollbackErr.FailedDn != "sys/svc-ext/dns-svc/dns-192.0.2.2" {
+		t.Fatalf("Got error %v, expect rollback of sys/svc-ext/dns-svc/dns-192.0.2.2", err)
+	}
+
+	// Errors which are not caused by the changes are not rollbacks.
+	reply = `<configConfMos response="yes" errorCode="553" invocationResult="unidentified-fail" errorDescr="Authorization denied"/>`
+	_, err = client.ConfigConfMos(ctx, req)
+	if errors.As(err, &rollbackErr) || !IsAuthorizationDenied(err) {
+		t.Fatalf("Got error %v of type %T, expect authorization denied", err, err)
+	}
+}
+
+func TestResolveParentAndChildren(t *testing.T) {
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveParent": func(req testRequest) string {
+			return `<configResolveParent dn="` + req.Attrs["dn"] + `" response="yes"><outConfig><equipmentChassis dn="sys/chassis-1" id="1"/></outConfig></configResolveParent>`
+		},
+		"configResolveChildren": func(req testRequest) string {
+			if req.Attrs["inDn"] != "sys/chassis-1" || req.Attrs["classId"] != "computeBlade" {
+				t.Errorf("Got unexpected request %s", req.Body)
+			}
+			return `<configResolveChildren response="yes"><outConfigs><computeBlade dn="sys/chassis-1/blade-1"/><computeBlade dn="sys/chassis-1/blade-2"/></outConfigs></configResolveChildren>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	blade := mo.ComputeBlade{}
+	if err := client.ResolveParent(ctx, blade, &struct{}{}); err != ErrNoDn {
+		t.Fatalf("Got error %v, expect %v", err, ErrNoDn)
+	}
+
+	blade.Dn = "sys/chassis-1/blade-1"
+
+	var chassis mo.EquipmentChassis
+	if err := client.ResolveParent(ctx, &blade, &chassis); err != nil {
+		t.Fatalf("Cannot resolve parent: %s", err)
+	}
+
+	if chassis.Dn != "sys/chassis-1" {
+		t.Fatalf("Got parent %q, expect %q", chassis.Dn, "sys/chassis-1")
+	}
+
+	var out struct {
+		XMLName xml.Name
+		Blades  []mo.ComputeBlade `xml:"computeBlade"`
+	}
+	if err := client.ResolveChildren(ctx, chassis, "computeBlade", &out); err != nil {
+		t.Fatalf("Cannot resolve children: %s", err)
+	}
+
+	if len(out.Blades) != 2 {
+		t.Fatalf("Got %d children, expect 2", len(out.Blades))
+	}
+}
+
+func TestConfigScope(t *testing.T) {
+	var body string
+	ts := newTestServer(t, map[string]testHandler{
+		"configScope": func(req testRequest) string {
+			body = string(req.Body)
+			return `<configScope dn="sys/chassis-1" response="yes"><outConfigs>` +
+				`<memoryUnit dn="sys/chassis-1/blade-1/board/memarray-1/mem-1" capacity="16384"/>` +
+				`<memoryUnit dn="sys/chassis-1/blade-2/board/memarray-1/mem-1" capacity="16384"/>` +
+				`</outConfigs></configScope>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	req := ConfigScopeRequest{
+		Dn:             "sys/chassis-1",
+		InClass:        "memoryUnit",
+		InHierarchical: "false",
+		InFilter: FilterEq{
+			FilterProperty: FilterProperty{
+				Class:    "memoryUnit",
+				Property: "presence",
+				Value:    "equipped",
+			},
+		},
+	}
+
+	var out struct {
+		XMLName xml.Name
+		Units   []mo.MemoryUnit `xml:"memoryUnit"`
+	}
+	if err := client.ConfigScope(context.Background(), req, &out); err != nil {
+		t.Fatalf("Cannot resolve scope: %s", err)
+	}
+
+	expect := `<configScope cookie="" dn="sys/chassis-1" inClass="memoryUnit" inHierarchical="false"><inFilter><eq class="memoryUnit" property="presence" value="equipped"/></inFilter></configScope>`
+	if body != expect {
+		t.Fatalf("Got request '%s', expect '%s'", body, expect)
+	}
+
+	if len(out.Units) != 2 || out.Units[0].Capacity != "16384" {
+		t.Fatalf("Got memory units %+v", out.Units)
+	}
+}
+
+func TestConfigFindDnsByClassId(t *testing.T) {
+	var body string
+	ts := newTestServer(t, map[string]testHandler{
+		"configFindDnsByClassId": func(req testRequest) string {
+			body = string(req.Body)
+			return `<configFindDnsByClassId classId="computeBlade" response="yes"><outDns>` +
+				`<dn value="sys/chassis-1/blade-1"/>` +
+				`<dn value="sys/chassis-1/blade-2"/>` +
+				`</outDns></configFindDnsByClassId>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	req := ConfigFindDnsByClassIdRequest{
+		ClassId: "computeBlade",
+		InFilter: FilterEq{
+			FilterProperty: FilterProperty{
+				Class:    "computeBlade",
+				Property: "chassisId",
+				Value:    "1",
+			},
+		},
+	}
+
+	dns, err := client.ConfigFindDnsByClassId(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Cannot find DNs: %s", err)
+	}
+
+	expect := `<configFindDnsByClassId cookie="" classId="computeBlade"><inFilter><eq class="computeBlade" property="chassisId" value="1"/></inFilter></configFindDnsByClassId>`
+	if body != expect {
+		t.Fatalf("Got request '%s', expect '%s'", body, expect)
+	}
+
+	if len(dns) != 2 || dns[0].Value != "sys/chassis-1/blade-1" || dns[1].Value != "sys/chassis-1/blade-2" {
+		t.Fatalf("Got DNs %+v", dns)
+	}
+}
+
+func TestLsInstantiateTemplateAndClone(t *testing.T) {
+	bodies := make(map[string]string)
+	ts := newTestServer(t, map[string]testHandler{
+		"lsInstantiateTemplate": func(req testRequest) string {
+			bodies[req.Method] = string(req.Body)
+			if req.Attrs["dn"] == "org-root/ls-empty" {
+				return `<lsInstantiateTemplate dn="org-root/ls-empty" response="yes"><outConfig></outConfig></lsInstantiateTemplate>`
+			}
+			return `<lsInstantiateTemplate dn="org-root/ls-tmpl" response="yes"><outConfig>` +
+				`<lsServer dn="org-root/org-web/ls-web01" name="web01" srcTemplName="tmpl"/>` +
+				`</outConfig></lsInstantiateTemplate>`
+		},
+		"lsInstantiateNTemplate": func(req testRequest) string {
+			bodies[req.Method] = string(req.Body)
+			switch req.Attrs["dn"] {
+			case "org-root/ls-empty":
+				return `<lsInstantiateNTemplate dn="org-root/ls-empty" response="yes"><outConfigs></outConfigs></lsInstantiateNTemplate>`
+			case "org-root/ls-nodn":
+				return `<lsInstantiateNTemplate dn="org-root/ls-nodn" response="yes"><outConfigs>` +
+					`<lsServer dn="org-root/org-web/ls-web1" name="web1"/>` +
+					`<lsServer name="web2"/>` +
+					`</outConfigs></lsInstantiateNTemplate>`
+			}
+			return `<lsInstantiateNTemplate dn="org-root/ls-tmpl" response="yes"><outConfigs>` +
+				`<lsServer dn="org-root/org-web/ls-web1" name="web1"/>` +
+				`<lsServer dn="org-root/org-web/ls-web2" name="web2"/>` +
+				`</outConfigs></lsInstantiateNTemplate>`
+		},
+		"lsClone": func(req testRequest) string {
+			bodies[req.Method] = string(req.Body)
+			if req.Attrs["dn"] == "org-root/ls-empty" {
+				return `<lsClone dn="org-root/ls-empty" response="yes"><outConfig></outConfig></lsClone>`
+			}
+			return `<lsClone dn="org-root/ls-web01" response="yes"><outConfig>` +
+				`<lsServer dn="org-root/ls-web01-copy" name="web01-copy"/>` +
+				`</outConfig></lsClone>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	dn, err := client.LsInstantiateTemplate(ctx, LsInstantiateTemplateRequest{
+		Dn:                "org-root/ls-tmpl",
+		InTargetOrg:       "org-root/org-web",
+		InServerName:      "web01",
+		InErrorOnExisting: "true",
+		InHierarchical:    "false",
+	})
+	if err != nil {
+		t.Fatalf("Cannot instantiate template: %s", err)
+	}
+
+	if dn != "org-root/org-web/ls-web01" {
+		t.Fatalf("Got DN %q, expect %q", dn, "org-root/org-web/ls-web01")
+	}
+
+	dns, err := client.LsInstantiateNTemplate(ctx, LsInstantiateNTemplateRequest{
+		Dn:                        "org-root/ls-tmpl",
+		InTargetOrg:               "org-root/org-web",
+		InServerNamePrefixOrEmpty: "web",
+		InNumberOf:                2,
+	})
+	if err != nil {
+		t.Fatalf("Cannot instantiate template: %s", err)
+	}
+
+	if len(dns) != 2 || dns[0] != "org-root/org-web/ls-web1" || dns[1] != "org-root/org-web/ls-web2" {
+		t.Fatalf("Got DNs %q", dns)
+	}
+
+	dn, err = client.LsClone(ctx, LsCloneRequest{
+		Dn:           "org-root/ls-web01",
+		InTargetOrg:  "org-root",
+		InServerName: "web01-copy",
+	})
+	if err != nil {
+		t.Fatalf("Cannot clone service profile: %s", err)
+	}
+
+	if dn != "org-root/ls-web01-copy" {
+		t.Fatalf("Got DN %q, expect %q", dn, "org-root/ls-web01-copy")
+	}
+
+	expect := map[string]string{
+		"lsInstantiateTemplate":  `<lsInstantiateTemplate cookie="" dn="org-root/ls-tmpl" inTargetOrg="org-root/org-web" inServerName="web01" inErrorOnExisting="true" inHierarchical="false"/>`,
+		"lsInstantiateNTemplate": `<lsInstantiateNTemplate cookie="" dn="org-root/ls-tmpl" inTargetOrg="org-root/org-web" inServerNamePrefixOrEmpty="web" inNumberOf="2"/>`,
+		"lsClone":                `<lsClone cookie="" dn="org-root/ls-web01" inTargetOrg="org-root" inServerName="web01-copy"/>`,
+	}
+	for method, body := range expect {
+		if bodies[method] != body {
+			t.Fatalf("Got request '%s', expect '%s'", bodies[method], body)
+		}
+	}
+
+	// An empty response must not be mistaken for a service profile without a DN.
+	if _, err := client.LsInstantiateTemplate(ctx, LsInstantiateTemplateRequest{Dn: "org-root/ls-empty"}); !errors.Is(err, ErrNoObjectReturned) {
+		t.Fatalf("Got error %v, expect %v", err, ErrNoObjectReturned)
+	}
+
+	if _, err := client.LsClone(ctx, LsCloneRequest{Dn: "org-root/ls-empty"}); !errors.Is(err, ErrNoObjectReturned) {
+		t.Fatalf("Got error %v, expect %v", err, ErrNoObjectReturned)
+	}
+
+	for _, dn := range []string{"org-root/ls-empty", "org-root/ls-nodn"} {
+		dns, err := client.LsInstantiateNTemplate(ctx, LsInstantiateNTemplateRequest{Dn: dn, InNumberOf: 2})
+		if !errors.Is(err, ErrNoObjectReturned) {
+			t.Fatalf("Got DNs %q with error %v for %s, expect %v", dns, err, dn, ErrNoObjectReturned)
+		}
+	}
+}
+
+func TestRequestCookie(t *testing.T) {
+	var cookies []string
+	ts := newTestServer(t, map[string]testHandler{
+		"aaaLogin": func(req testRequest) string {
+			return `<aaaLogin response="yes" outCookie="cookie-1" outRefreshPeriod="600"/>`
+		},
+		"configResolveDn": func(req testRequest) string {
+			cookies = append(cookies, req.Attrs["cookie"])
+			return `<configResolveDn dn="sys" response="yes"><outConfig><topSystem dn="sys"/></outConfig></configResolveDn>`
+		},
+	})
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	// Requests passed by value as well as by pointer are sent with the
+	// cookie of the current session, regardless of the session manager.
+	for _, config := range []Config{{}, {Session: &Session{}}} {
+		cookies = nil
+		client := ts.NewClient(t, config)
+		if _, err := client.AaaLogin(ctx); err != nil {
+			t.Fatalf("Cannot login: %s", err)
+		}
+
+		req := ConfigResolveDnRequest{Dn: "sys"}
+		var resp ConfigResolveDnResponse
+		if err := client.Request(ctx, req, &resp); err != nil {
+			t.Fatalf("Cannot send request: %s", err)
+		}
+		if err := client.RequestNow(ctx, req, &resp); err != nil {
+			t.Fatalf("Cannot send request: %s", err)
+		}
+		if err := client.Request(ctx, &req, &resp); err != nil {
+			t.Fatalf("Cannot send request: %s", err)
+		}
+		client.Close()
+
+		for i, cookie := range cookies {
+			if cookie != "cookie-1" {
+				t.Fatalf("Got cookie %q for request %d, expect %q", cookie, i, "cookie-1")
+			}
+		}
+
+		if len(cookies) != 3 {
+			t.Fatalf("Got %d requests, expect 3", len(cookies))
+		}
+	}
+}
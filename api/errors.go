@@ -0,0 +1,206 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Well-known error codes returned by the remote Cisco UCS API endpoint.
+const (
+	// ErrorCodeInvalidArgument is returned when a request is malformed or
+	// contains an invalid argument.
+	ErrorCodeInvalidArgument = "101"
+
+	// ErrorCodeObjectNotFound is returned when a request refers to a
+	// managed object which does not exist.
+	ErrorCodeObjectNotFound = "102"
+
+	// ErrorCodeAuthenticationFailed is returned when logging in with
+	// invalid credentials.
+	ErrorCodeAuthenticationFailed = "551"
+
+	// ErrorCodeSessionExpired is returned when a request is sent with an
+	// expired or otherwise invalid authentication cookie.
+	ErrorCodeSessionExpired = "552"
+
+	// ErrorCodeAuthorizationDenied is returned when the user does not have
+	// the privileges required by the request.
+	ErrorCodeAuthorizationDenied = "553"
+
+	// ErrorCodeThrottled is returned when the remote endpoint rejects a
+	// request because it is overloaded or the session limit is reached.
+	ErrorCodeThrottled = "572"
+)
+
+// Sentinel errors for the well-known error codes, which can be used
+// with errors.Is in order to check the kind of an *Error.
+var (
+	ErrInvalidArgument      = &Error{Code: ErrorCodeInvalidArgument, Description: "invalid argument"}
+	ErrObjectNotFound       = &Error{Code: ErrorCodeObjectNotFound, Description: "object not found"}
+	ErrAuthenticationFailed = &Error{Code: ErrorCodeAuthenticationFailed, Description: "authentication failed"}
+	ErrSessionExpired       = &Error{Code: ErrorCodeSessionExpired, Description: "session expired"}
+	ErrAuthorizationDenied  = &Error{Code: ErrorCodeAuthorizationDenied, Description: "authorization denied"}
+	ErrThrottled            = &Error{Code: ErrorCodeThrottled, Description: "throttled"}
+)
+
+// ErrNoDn is returned when a managed object does not have a DN.
+var ErrNoDn = errors.New("managed object has no DN")
+
+// ErrNoObjectReturned is returned when the remote endpoint accepts a request
+// creating managed objects, but does not return the created managed objects.
+var ErrNoObjectReturned = errors.New("created managed object not returned")
+
+// Error represents an error returned by the remote Cisco UCS API endpoint.
+type Error struct {
+	// Method is the name of the XML API method which failed, e.g. configResolveDn.
+	Method string
+
+	// Code is the error code as returned in the errorCode attribute.
+	Code string
+
+	// Description is the error description as returned in the errorDescr attribute.
+	Description string
+
+	// InvocationResult is the result as returned in the invocationResult attribute.
+	InvocationResult string
+}
+
+// newError creates a new *Error from the error fields of the given response.
+func newError(method string, b *BaseResponse) *Error {
+	err := &Error{
+		Method:           method,
+		Code:             b.ErrorCode,
+		Description:      b.ErrorDescription,
+		InvocationResult: b.InvocationResult,
+	}
+
+	return err
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%s: %s (code %s)", e.Description, e.InvocationResult, e.Code)
+	if e.Method != "" {
+		msg = e.Method + ": " + msg
+	}
+
+	return msg
+}
+
+// Is reports whether target is an *Error with the same error code.
+// This allows errors to be compared against the sentinel errors using errors.Is.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	return e.Code == t.Code
+}
+
+// RollbackError is returned when the remote endpoint rejects one of the changes
+// sent in a single transaction, e.g. using configConfMos. The remote endpoint
+// rolls back the whole transaction, so none of the changes are applied.
+type RollbackError struct {
+	// Err is the error returned by the remote endpoint.
+	Err *Error
+
+	// Dns contains the DNs of all managed objects, which were rolled back.
+	Dns []string
+
+	// FailedDn is the DN of the managed object, whose change was rejected by
+	// the remote endpoint. It is empty if the error returned by the remote
+	// endpoint does not refer to any of the managed objects.
+	FailedDn string
+}
+
+// newRollbackError creates a new *RollbackError for the given error rejecting the
+// changes to the managed objects with the given DNs. The rejected change is the
+// one to the managed object with the longest DN referred to by the error description.
+func newRollbackError(err *Error, dns []string) *RollbackError {
+	rollbackErr := &RollbackError{
+		Err: err,
+		Dns: dns,
+	}
+
+	for _, dn := range dns {
+		if strings.Contains(err.Description, dn) && len(dn) > len(rollbackErr.FailedDn) {
+			rollbackErr.FailedDn = dn
+		}
+	}
+
+	return rollbackErr
+}
+
+// Error implements the error interface.
+func (e *RollbackError) Error() string {
+	if e.FailedDn != "" {
+		return fmt.Sprintf("%s: change to %s rejected, rolled back changes to %s", e.Err, e.FailedDn, strings.Join(e.Dns, ", "))
+	}
+
+	return fmt.Sprintf("%s: rolled back changes to %s", e.Err, strings.Join(e.Dns, ", "))
+}
+
+// Unwrap returns the error returned by the remote endpoint.
+func (e *RollbackError) Unwrap() error {
+	return e.Err
+}
+
+// IsInvalidArgument returns a boolean indicating whether the error was
+// caused by a malformed request or an invalid argument.
+func IsInvalidArgument(err error) bool {
+	return errors.Is(err, ErrInvalidArgument)
+}
+
+// IsObjectNotFound returns a boolean indicating whether the error was
+// caused by a managed object, which does not exist.
+func IsObjectNotFound(err error) bool {
+	return errors.Is(err, ErrObjectNotFound)
+}
+
+// IsAuthenticationFailed returns a boolean indicating whether the error
+// was caused by invalid credentials.
+func IsAuthenticationFailed(err error) bool {
+	return errors.Is(err, ErrAuthenticationFailed)
+}
+
+// IsSessionExpired returns a boolean indicating whether the error was
+// caused by an expired or invalid authentication cookie.
+func IsSessionExpired(err error) bool {
+	return errors.Is(err, ErrSessionExpired)
+}
+
+// IsAuthorizationDenied returns a boolean indicating whether the error was
+// caused by the user not having the privileges required by the request.
+func IsAuthorizationDenied(err error) bool {
+	return errors.Is(err, ErrAuthorizationDenied)
+}
+
+// IsThrottled returns a boolean indicating whether the request was rejected
+// by the remote endpoint because it is overloaded.
+func IsThrottled(err error) bool {
+	return errors.Is(err, ErrThrottled)
+}
+
+// methodName returns the name of the XML API method of the given request,
+// which is the name of the root XML element as specified by its XMLName field.
+func methodName(in interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(in))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	field, ok := v.Type().FieldByName("XMLName")
+	if !ok {
+		return ""
+	}
+
+	name := strings.Split(field.Tag.Get("xml"), ",")[0]
+	if i := strings.LastIndex(name, " "); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return name
+}
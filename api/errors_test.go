@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorIs(t *testing.T) {
+	var tests = []struct {
+		err    error
+		target error
+		expect bool
+	}{
+		{err: &Error{Code: ErrorCodeSessionExpired}, target: ErrSessionExpired, expect: true},
+		{err: &Error{Code: ErrorCodeObjectNotFound}, target: ErrObjectNotFound, expect: true},
+		{err: fmt.Errorf("wrapped: %w", &Error{Code: ErrorCodeAuthorizationDenied}), target: ErrAuthorizationDenied, expect: true},
+		{err: &Error{Code: ErrorCodeInvalidArgument}, target: ErrSessionExpired, expect: false},
+		{err: errors.New("some error"), target: ErrSessionExpired, expect: false},
+		{err: nil, target: ErrSessionExpired, expect: false},
+	}
+
+	for _, test := range tests {
+		if got := errors.Is(test.err, test.target); got != test.expect {
+			t.Fatalf("errors.Is(%v, %v) is %t, expect %t", test.err, test.target, got, test.expect)
+		}
+	}
+}
+
+func TestClientReturnsError(t *testing.T) {
+	ts := newTestServer(t, map[string]testHandler{
+		"aaaLogin": func(req testRequest) string {
+			return `<aaaLogin response="yes" errorCode="551" invocationResult="unidentified-fail" errorDescr="Authentication failed"/>`
+		},
+		"configResolveDn": func(req testRequest) string {
+			return `<configResolveDn dn="sys" response="yes" errorCode="553" invocationResult="unidentified-fail" errorDescr="Authorization denied"/>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	_, err := client.AaaLogin(ctx)
+	if !IsAuthenticationFailed(err) {
+		t.Fatalf("Got error %v, expect authentication failure", err)
+	}
+
+	var sys struct{}
+	err = client.ConfigResolveDn(ctx, ConfigResolveDnRequest{Dn: "sys"}, &sys)
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Got error %v of type %T, expect *Error", err, err)
+	}
+
+	expect := Error{
+		Method:           "configResolveDn",
+		Code:             ErrorCodeAuthorizationDenied,
+		Description:      "Authorization denied",
+		InvocationResult: "unidentified-fail",
+	}
+	if *apiErr != expect {
+		t.Fatalf("Got error %+v, expect %+v", *apiErr, expect)
+	}
+}
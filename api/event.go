@@ -0,0 +1,268 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// The maximum time cancelling a subscription using eventUnsubscribe may take.
+const defaultUnsubscribeTimeout = 30 * time.Second
+
+// Event represents a change of a managed object as reported by the remote API endpoint.
+type Event struct {
+	// Id is the event id assigned by the remote API endpoint.
+	Id int64
+
+	// Class is the class of the changed managed object, e.g. computeBlade.
+	Class string
+
+	// Dn is the DN of the changed managed object.
+	Dn string
+
+	// Status describes the change, e.g. created, modified or deleted.
+	Status string
+
+	// Object is the XML document of the changed managed object. For modified
+	// managed objects it contains only the properties which have changed.
+	Object []byte
+}
+
+// newEvent creates a new Event from the given event notification.
+func newEvent(e ConfigMoChangeEvent) (*Event, error) {
+	var obj struct {
+		XMLName xml.Name
+		Dn      string `xml:"dn,attr"`
+		Status  string `xml:"status,attr"`
+	}
+
+	if err := xml.Unmarshal(e.InConfig.Inner, &obj); err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		Id:     e.InEid,
+		Class:  obj.XMLName.Local,
+		Dn:     obj.Dn,
+		Status: obj.Status,
+		Object: e.InConfig.Inner,
+	}
+
+	return event, nil
+}
+
+// Decode unmarshals the changed managed object into the given concrete type.
+func (e *Event) Decode(out mo.Any) error {
+	return xml.Unmarshal(e.Object, &out)
+}
+
+// Subscription delivers the event notifications of the remote API endpoint.
+type Subscription struct {
+	events chan *Event
+
+	// mu guards the error which ended the subscription.
+	mu  sync.Mutex
+	err error
+}
+
+// Events returns the channel on which events are delivered. The channel
+// is closed when the subscription ends.
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Err returns the error which ended the subscription, once the events channel
+// is closed. If the subscription was cancelled the error of the context is returned.
+func (s *Subscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.err
+}
+
+// run keeps the subscription active until the given context is done or
+// the event stream ends, cancelling the subscription on the remote endpoint
+// when the context is done.
+func (s *Subscription) run(ctx context.Context, c *Client) {
+	in := EventSubscribeRequest{}
+	resp := eventStream{ctx: ctx, events: s.events}
+	err := c.Request(ctx, &in, &resp)
+
+	if ctx.Err() != nil {
+		err = ctx.Err()
+		if c.Cookie() != "" {
+			unsubscribeCtx, cancel := context.WithTimeout(context.Background(), defaultUnsubscribeTimeout)
+			c.EventUnsubscribe(unsubscribeCtx)
+			cancel()
+		}
+	}
+
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+
+	close(s.events)
+}
+
+// Subscribe subscribes to the event notifications of the remote API endpoint
+// using eventSubscribe. The subscription remains active until the given context
+// is done, after which it is cancelled using eventUnsubscribe, or until the
+// event stream ends. The events channel is closed when the subscription ends.
+//
+// Since the subscription is a single long-lived HTTP request, the HTTP client
+// of the Client must not have a timeout configured.
+func (c *Client) Subscribe(ctx context.Context) *Subscription {
+	s := &Subscription{
+		events: make(chan *Event),
+	}
+
+	go s.run(ctx, c)
+
+	return s
+}
+
+// EventUnsubscribe cancels the subscription to the event notifications of the
+// remote API endpoint, which was created using the current authentication cookie.
+func (c *Client) EventUnsubscribe(ctx context.Context) (*EventUnsubscribeResponse, error) {
+	var resp EventUnsubscribeResponse
+	req := EventUnsubscribeRequest{}
+
+	if err := c.RequestNow(ctx, &req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// eventStream is a response, which delivers the event notifications contained
+// within the response to an eventSubscribe request as they are parsed.
+// Each event notification is a separate XML document preceded by its length.
+type eventStream struct {
+	BaseResponse
+
+	ctx       context.Context
+	events    chan<- *Event
+	delivered bool
+}
+
+// reset discards the decoded response, keeping the events channel in place.
+func (r *eventStream) reset() {
+	r.BaseResponse = BaseResponse{}
+}
+
+// partial returns a boolean indicating whether any events were delivered.
+func (r *eventStream) partial() bool {
+	return r.delivered
+}
+
+// decodeBody decodes the event notifications from the given XML token stream
+// until the stream ends or the remote endpoint rejects the subscription.
+// The remote endpoint closing the stream between two event notifications
+// is a normal end of the stream, while closing it within an event
+// notification is reported as io.ErrUnexpectedEOF.
+func (r *eventStream) decodeBody(d *xml.Decoder) error {
+	for {
+		start, err := nextFrame(d)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch start.Name.Local {
+		case "eventSubscribe":
+			// The remote endpoint responds with an eventSubscribe
+			// document only when the subscription is rejected.
+			if err := d.DecodeElement(&r.BaseResponse, &start); err != nil {
+				return truncated(err)
+			}
+
+			if r.IsError() {
+				return nil
+			}
+		case "configMoChangeEvent":
+			var e ConfigMoChangeEvent
+			if err := d.DecodeElement(&e, &start); err != nil {
+				return truncated(err)
+			}
+
+			if err := r.deliver(e); err != nil {
+				return err
+			}
+		case "methodVessel":
+			var vessel MethodVessel
+			if err := d.DecodeElement(&vessel, &start); err != nil {
+				return truncated(err)
+			}
+
+			for _, e := range vessel.InStimuli {
+				if err := r.deliver(e); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := d.Skip(); err != nil {
+				return truncated(err)
+			}
+		}
+	}
+}
+
+// nextFrame returns the start element of the next event notification from the
+// given XML token stream. It returns io.EOF if the stream ends before the length
+// of the next event notification and io.ErrUnexpectedEOF if it ends after it.
+func nextFrame(d *xml.Decoder) (xml.StartElement, error) {
+	announced := false
+	for {
+		token, err := d.Token()
+		if err == io.EOF && announced {
+			return xml.StartElement{}, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return xml.StartElement{}, truncated(err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			return t, nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				announced = true
+			}
+		}
+	}
+}
+
+// truncated returns io.ErrUnexpectedEOF if the given error is caused by the
+// XML token stream ending within an element, otherwise the error itself.
+func truncated(err error) error {
+	var syntaxErr *xml.SyntaxError
+	if errors.As(err, &syntaxErr) && syntaxErr.Msg == "unexpected EOF" {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// deliver sends the given event notification on the events channel.
+func (r *eventStream) deliver(e ConfigMoChangeEvent) error {
+	event, err := newEvent(e)
+	if err != nil {
+		return err
+	}
+
+	r.delivered = true
+	select {
+	case r.events <- event:
+		return nil
+	case <-r.ctx.Done():
+		return r.ctx.Err()
+	}
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// eventFrames are the event notifications sent by newEventServer,
+// each of them preceded by its length.
+var eventFrames = []string{
+	`<methodVessel cookie=""><inStimuli>` +
+		`<configMoChangeEvent cookie="" inEid="101"><inConfig><computeBlade dn="sys/chassis-1/blade-1" status="modified" operState="ok"/></inConfig></configMoChangeEvent>` +
+		`<configMoChangeEvent cookie="" inEid="102"><inConfig><faultInst dn="sys/chassis-1/blade-1/fault-F0283" status="created"/></inConfig></configMoChangeEvent>` +
+		`</inStimuli></methodVessel>`,
+	`<configMoChangeEvent cookie="" inEid="103"><inConfig><computeBlade dn="sys/chassis-1/blade-2" status="deleted"/></inConfig></configMoChangeEvent>`,
+}
+
+// newEventServer creates a fake Cisco UCS API endpoint, which sends the event
+// frames to subscribers and keeps the stream open until the client disconnects.
+func newEventServer(t *testing.T, unsubscribed *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Cannot read request body: %s", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch {
+		case strings.HasPrefix(string(body), "<eventSubscribe"):
+			for _, frame := range eventFrames {
+				fmt.Fprintf(w, "%d\n%s", len(frame), frame)
+			}
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		case strings.HasPrefix(string(body), "<eventUnsubscribe"):
+			atomic.AddInt32(unsubscribed, 1)
+			fmt.Fprint(w, `<eventUnsubscribe cookie="cookie-1" response="yes"/>`)
+		default:
+			t.Errorf("Unexpected request %q", body)
+		}
+	}))
+}
+
+func TestSubscribe(t *testing.T) {
+	var unsubscribed int32
+	ts := newEventServer(t, &unsubscribed)
+	defer ts.Close()
+
+	client, err := NewClient(Config{Endpoint: ts.URL + "/"})
+	if err != nil {
+		t.Fatalf("Cannot create client: %s", err)
+	}
+	client.setCookie("cookie-1", 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub := client.Subscribe(ctx)
+
+	var events []*Event
+	for event := range sub.Events() {
+		events = append(events, event)
+		if len(events) == 3 {
+			cancel()
+		}
+	}
+
+	if err := sub.Err(); err != context.Canceled {
+		t.Fatalf("Got error %v, expect %v", err, context.Canceled)
+	}
+
+	if got := atomic.LoadInt32(&unsubscribed); got != 1 {
+		t.Fatalf("Got %d unsubscribe requests, expect 1", got)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("Got %d events, expect 3", len(events))
+	}
+
+	expect := []Event{
+		{Id: 101, Class: "computeBlade", Dn: "sys/chassis-1/blade-1", Status: mo.StatusModified},
+		{Id: 102, Class: "faultInst", Dn: "sys/chassis-1/blade-1/fault-F0283", Status: mo.StatusCreated},
+		{Id: 103, Class: "computeBlade", Dn: "sys/chassis-1/blade-2", Status: mo.StatusDeleted},
+	}
+	for i, e := range expect {
+		got := events[i]
+		if got.Id != e.Id || got.Class != e.Class || got.Dn != e.Dn || got.Status != e.Status {
+			t.Fatalf("Got event %+v, expect %+v", got, e)
+		}
+	}
+
+	var blade mo.ComputeBlade
+	if err := events[0].Decode(&blade); err != nil {
+		t.Fatalf("Cannot decode event: %s", err)
+	}
+
+	if blade.Dn != "sys/chassis-1/blade-1" || blade.OperationalState != "ok" {
+		t.Fatalf("Got blade %s with state %q", blade.Dn, blade.OperationalState)
+	}
+}
+
+func TestSubscribeRejected(t *testing.T) {
+	ts := newTestServer(t, map[string]testHandler{
+		"eventSubscribe": func(req testRequest) string {
+			return `<eventSubscribe response="yes" errorCode="552" invocationResult="unidentified-fail" errorDescr="Authorization required"/>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	sub := client.Subscribe(context.Background())
+
+	for event := range sub.Events() {
+		t.Fatalf("Got unexpected event %+v", event)
+	}
+
+	if err := sub.Err(); !IsSessionExpired(err) {
+		t.Fatalf("Got error %v, expect session expired", err)
+	}
+}
+
+func TestSubscribeStreamEnd(t *testing.T) {
+	tests := []struct {
+		name   string
+		tail   string
+		events int
+		err    error
+	}{
+		{name: "closed", tail: "", events: 3, err: nil},
+		{name: "truncated length", tail: "120\n", events: 3, err: io.ErrUnexpectedEOF},
+		{name: "truncated event", tail: "120\n" + eventFrames[1][:60], events: 3, err: io.ErrUnexpectedEOF},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// The server closes the stream after the event frames.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/xml")
+				for _, frame := range eventFrames {
+					fmt.Fprintf(w, "%d\n%s", len(frame), frame)
+				}
+				fmt.Fprint(w, tc.tail)
+			}))
+			defer ts.Close()
+
+			client, err := NewClient(Config{Endpoint: ts.URL + "/"})
+			if err != nil {
+				t.Fatalf("Cannot create client: %s", err)
+			}
+			client.setCookie("cookie-1", 0)
+
+			sub := client.Subscribe(context.Background())
+
+			events := 0
+			for range sub.Events() {
+				events++
+			}
+
+			if events != tc.events {
+				t.Fatalf("Got %d events, expect %d", events, tc.events)
+			}
+
+			if err := sub.Err(); err != tc.err {
+				t.Fatalf("Got error %v, expect %v", err, tc.err)
+			}
+		})
+	}
+}
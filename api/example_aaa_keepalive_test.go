@@ -44,7 +44,7 @@ func Example_aaaKeepAlive() {
 	}
 	defer client.AaaLogout(ctx)
 
-	log.Printf("Got authentication cookie: %s\n", client.Cookie)
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
 
 	// Channel on which the shutdown signal is sent
 	quit := make(chan os.Signal, 1)
@@ -40,5 +40,5 @@ func Example_aaaLogin() {
 	}
 	defer client.AaaLogout(ctx)
 
-	log.Printf("Got authentication cookie: %s\n", client.Cookie)
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
 }
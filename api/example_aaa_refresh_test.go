@@ -40,12 +40,12 @@ func Example_aaaRefresh() {
 	}
 	defer client.AaaLogout(ctx)
 
-	log.Printf("Got authentication cookie: %s\n", client.Cookie)
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
 
 	log.Println("Refreshing session")
 	if _, err := client.AaaRefresh(ctx); err != nil {
 		log.Fatalf("Unable to refresh session: %s\n", err)
 	}
 
-	log.Printf("New authentication cookie is: %s\n", client.Cookie)
+	log.Printf("New authentication cookie is: %s\n", client.Cookie())
 }
@@ -41,7 +41,7 @@ func Example_compositeFilter() {
 	}
 	defer client.AaaLogout(ctx)
 
-	log.Printf("Got authentication cookie: %s\n", client.Cookie)
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
 
 	// The type into which we unmarshal the result data
 	type blades struct {
@@ -71,7 +71,6 @@ func Example_compositeFilter() {
 	}
 
 	req := api.ConfigResolveClassRequest{
-		Cookie:         client.Cookie,
 		InHierarchical: "false",
 		ClassId:        "computeBlade",
 		InFilter:       filter,
@@ -90,7 +89,7 @@ func Example_compositeFilter() {
 		log.Printf("\tNumber of CPUs: %d\n", blade.NumOfCpus)
 		log.Printf("\tTotal Memory: %d\n", blade.TotalMemory)
 		log.Printf("\tModel: %s\n", blade.Model)
-		log.Printf("\tChassis ID: %d\n", blade.ChassisId)
+		log.Printf("\tChassis ID: %s\n", blade.ChassisId)
 		log.Printf("\tVendor: %s\n", blade.Vendor)
 	}
 }
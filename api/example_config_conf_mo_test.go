@@ -0,0 +1,66 @@
+package api_test
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net/http"
+
+	"github.com/dnaeon/go-ucs/api"
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func Example_configConfMo() {
+	// The following example shows how to create a managed object,
+	// in this case a new DNS provider.
+
+	// Skip SSL certificate verification of remote endpoint.
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	httpClient := &http.Client{Transport: tr}
+
+	// Create a new Cisco UCS API client
+	config := api.Config{
+		Endpoint:   "https://ucs01.example.org/",
+		Username:   "admin",
+		Password:   "password",
+		HttpClient: httpClient,
+	}
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		log.Fatalf("Unable to create API client: %s", err)
+	}
+
+	ctx := context.Background()
+
+	log.Printf("Logging in to %s\n", config.Endpoint)
+	if _, err := client.AaaLogin(ctx); err != nil {
+		log.Fatalf("Unable to login: %s\n", err)
+	}
+	defer client.AaaLogout(ctx)
+
+	// The status attribute specifies that the managed object is created,
+	// or modified if it already exists. Use mo.StatusDeleted in order
+	// to delete the managed object instead.
+	provider := mo.CommDnsProvider{
+		Dn:     "sys/svc-ext/dns-svc/dns-192.0.2.1",
+		Name:   "192.0.2.1",
+		Status: mo.StatusCreatedModified,
+	}
+
+	req := api.ConfigConfMoRequest{
+		Dn:             provider.Dn,
+		InHierarchical: "false",
+		InConfig:       api.InConfig{Object: provider},
+	}
+
+	var out mo.CommDnsProvider
+	log.Printf("Creating DNS provider %s\n", provider.Name)
+	if err := client.ConfigConfMo(ctx, req, &out); err != nil {
+		log.Fatalf("Unable to create DNS provider: %s", err)
+	}
+
+	log.Printf("Created DNS provider %s\n", out.Dn)
+}
@@ -0,0 +1,91 @@
+package api_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/xml"
+	"log"
+	"net/http"
+
+	"github.com/dnaeon/go-ucs/api"
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func Example_configFindDnsByClassId() {
+	// The following example shows how to enumerate the DNs of the compute
+	// blades in a chassis first and then retrieve the blades selectively.
+
+	// Skip SSL certificate verification of remote endpoint.
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	httpClient := &http.Client{Transport: tr}
+
+	// Create a new Cisco UCS API client
+	config := api.Config{
+		Endpoint:   "https://ucs01.example.org/",
+		Username:   "admin",
+		Password:   "password",
+		HttpClient: httpClient,
+	}
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		log.Fatalf("Unable to create API client: %s", err)
+	}
+
+	ctx := context.Background()
+
+	log.Printf("Logging in to %s\n", config.Endpoint)
+	if _, err := client.AaaLogin(ctx); err != nil {
+		log.Fatalf("Unable to login: %s\n", err)
+	}
+	defer client.AaaLogout(ctx)
+
+	findReq := api.ConfigFindDnsByClassIdRequest{
+		ClassId: "computeBlade",
+		InFilter: api.FilterEq{
+			FilterProperty: api.FilterProperty{
+				Class:    "computeBlade",
+				Property: "chassisId",
+				Value:    "1",
+			},
+		},
+	}
+
+	log.Println("Retrieving DNs of managed objects with class `computeBlade`")
+	dns, err := client.ConfigFindDnsByClassId(ctx, findReq)
+	if err != nil {
+		log.Fatalf("Unable to retrieve DNs: %s", err)
+	}
+
+	log.Printf("Found %d compute blades\n", len(dns))
+	if len(dns) == 0 {
+		return
+	}
+
+	// The type into which we unmarshal the result data
+	type blades struct {
+		XMLName xml.Name
+		Blades  []mo.ComputeBlade `xml:"computeBlade"`
+	}
+
+	// Retrieve only the first two blades
+	if len(dns) > 2 {
+		dns = dns[:2]
+	}
+
+	resolveReq := api.ConfigResolveDnsRequest{
+		InHierarchical: "false",
+		InDns:          dns,
+	}
+
+	var out blades
+	if _, err := client.ConfigResolveDns(ctx, resolveReq, &out); err != nil {
+		log.Fatalf("Unable to retrieve compute blades: %s", err)
+	}
+
+	for _, blade := range out.Blades {
+		log.Printf("%s: %s\n", blade.Dn, blade.Model)
+	}
+}
@@ -41,7 +41,7 @@ func Example_configResolveClass() {
 	}
 	defer client.AaaLogout(ctx)
 
-	log.Printf("Got authentication cookie: %s\n", client.Cookie)
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
 
 	// The type into which we unmarshal the result data
 	type blades struct {
@@ -50,7 +50,6 @@ func Example_configResolveClass() {
 	}
 
 	req := api.ConfigResolveClassRequest{
-		Cookie:         client.Cookie,
 		ClassId:        "computeBlade",
 		InHierarchical: "false",
 	}
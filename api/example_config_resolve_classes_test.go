@@ -41,10 +41,9 @@ func Example_configResolveClasses() {
 	}
 	defer client.AaaLogout(ctx)
 
-	log.Printf("Got authentication cookie: %s\n", client.Cookie)
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
 
 	req := api.ConfigResolveClassesRequest{
-		Cookie:         client.Cookie,
 		InHierarchical: "false",
 		InIds: []api.Id{
 			api.NewId("computeBlade"),
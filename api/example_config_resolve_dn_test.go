@@ -40,12 +40,11 @@ func Example_configResolveDn() {
 	}
 	defer client.AaaLogout(ctx)
 
-	log.Printf("Got authentication cookie: %s\n", client.Cookie)
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
 
 	// Retrieve the `sys` DN, which is of type mo.TopSystem
 	log.Println("Retrieving `sys` managed object")
 	req := api.ConfigResolveDnRequest{
-		Cookie:         client.Cookie,
 		Dn:             "sys",
 		InHierarchical: "false",
 	}
@@ -42,7 +42,7 @@ func Example_configResolveDns() {
 	}
 	defer client.AaaLogout(ctx)
 
-	log.Printf("Got authentication cookie: %s\n", client.Cookie)
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
 
 	// A type to contain the instances of the retrieved DNs.
 	type outConfigs struct {
@@ -56,7 +56,6 @@ func Example_configResolveDns() {
 	// Retrieve the list of DNs
 	log.Println("Retrieving managed objects using configResolveDns query method")
 	req := api.ConfigResolveDnsRequest{
-		Cookie:         client.Cookie,
 		InHierarchical: "false",
 		InDns: []api.Dn{
 			api.NewDn("sys"),
@@ -0,0 +1,75 @@
+package api_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/xml"
+	"log"
+	"net/http"
+
+	"github.com/dnaeon/go-ucs/api"
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func Example_configScope() {
+	// The following example shows how to retrieve all equipped
+	// memory units of the blades in a single chassis.
+
+	// Skip SSL certificate verification of remote endpoint.
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	httpClient := &http.Client{Transport: tr}
+
+	// Create a new Cisco UCS API client
+	config := api.Config{
+		Endpoint:   "https://ucs01.example.org/",
+		Username:   "admin",
+		Password:   "password",
+		HttpClient: httpClient,
+	}
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		log.Fatalf("Unable to create API client: %s", err)
+	}
+
+	ctx := context.Background()
+
+	log.Printf("Logging in to %s\n", config.Endpoint)
+	if _, err := client.AaaLogin(ctx); err != nil {
+		log.Fatalf("Unable to login: %s\n", err)
+	}
+	defer client.AaaLogout(ctx)
+
+	// The type into which we unmarshal the result data
+	type memoryUnits struct {
+		XMLName xml.Name
+		Units   []mo.MemoryUnit `xml:"memoryUnit"`
+	}
+
+	req := api.ConfigScopeRequest{
+		Dn:             "sys/chassis-1",
+		InClass:        "memoryUnit",
+		InHierarchical: "false",
+		InFilter: api.FilterEq{
+			FilterProperty: api.FilterProperty{
+				Class:    "memoryUnit",
+				Property: "presence",
+				Value:    "equipped",
+			},
+		},
+	}
+
+	var out memoryUnits
+
+	log.Println("Retrieving managed objects with class `memoryUnit` below `sys/chassis-1`")
+	if err := client.ConfigScope(ctx, req, &out); err != nil {
+		log.Fatalf("Unable to retrieve `memoryUnit` managed objects: %s", err)
+	}
+
+	log.Printf("Retrieved %d memory units\n", len(out.Units))
+	for _, unit := range out.Units {
+		log.Printf("%s: %s MB\n", unit.Location, unit.Capacity)
+	}
+}
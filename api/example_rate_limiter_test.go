@@ -51,7 +51,7 @@ func Example_rateLimiter() {
 	}
 	defer client.AaaLogout(ctx)
 
-	log.Printf("Got authentication cookie: %s\n", client.Cookie)
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
 
 	// Start a few concurrent requests to the remote API endpoint.
 	// Requests will be executed one at a time, because of how the limiter is configured.
@@ -67,7 +67,6 @@ func Example_rateLimiter() {
 			// Retrieve the `sys` DN, which is of type mo.TopSystem
 			log.Printf("Worker #%d: Retrieving `sys` managed object\n", id)
 			req := api.ConfigResolveDnRequest{
-				Cookie:         client.Cookie,
 				Dn:             "sys",
 				InHierarchical: "false",
 			}
@@ -0,0 +1,67 @@
+package api_test
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/dnaeon/go-ucs/api"
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func Example_retry() {
+	// The following example shows how to retry requests, which failed because
+	// of a transient error, e.g. while a Fabric Interconnect fails over.
+
+	// Skip SSL certificate verification of remote endpoint.
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	httpClient := &http.Client{Transport: tr}
+
+	// Create a new Cisco UCS API client.
+	// Requests are attempted up to 5 times, waiting between 1 and 30 seconds
+	// between the attempts with 20% of the delay being randomized.
+	config := api.Config{
+		Endpoint:   "https://ucs01.example.org/",
+		Username:   "admin",
+		Password:   "password",
+		HttpClient: httpClient,
+		Retry: &api.RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: time.Duration(1 * time.Second),
+			MaxBackoff:     time.Duration(30 * time.Second),
+			Jitter:         0.2,
+		},
+	}
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		log.Fatalf("Unable to create API client: %s", err)
+	}
+
+	ctx := context.Background()
+
+	log.Printf("Logging in to %s\n", config.Endpoint)
+	if _, err := client.AaaLogin(ctx); err != nil {
+		log.Fatalf("Unable to login: %s\n", err)
+	}
+	defer client.AaaLogout(ctx)
+
+	req := api.ConfigResolveDnRequest{
+		Dn:             "sys",
+		InHierarchical: "false",
+	}
+
+	var sys mo.TopSystem
+	log.Println("Retrieving managed object with DN `sys`")
+	if err := client.ConfigResolveDn(ctx, req, &sys); err != nil {
+		log.Fatalf("Unable to retrieve DN: %s", err)
+	}
+
+	log.Printf("Address: %s\n", sys.Address)
+	log.Printf("Current time: %s\n", sys.CurrentTime)
+	log.Printf("Name: %s\n", sys.Name)
+}
@@ -0,0 +1,61 @@
+package api_test
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/dnaeon/go-ucs/api"
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func Example_session() {
+	// The following example shows how to let the client manage the session.
+	// The client logs in on the first request, refreshes the session ahead
+	// of its expiry and logs in again if the session expires.
+
+	// Skip SSL certificate verification of remote endpoint.
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	httpClient := &http.Client{Transport: tr}
+
+	// Create a new Cisco UCS API client with the session manager enabled.
+	// The session is refreshed two minutes before it expires.
+	config := api.Config{
+		Endpoint:   "https://ucs01.example.org/",
+		Username:   "admin",
+		Password:   "password",
+		HttpClient: httpClient,
+		Session: &api.Session{
+			RefreshMargin: time.Duration(2 * time.Minute),
+		},
+	}
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		log.Fatalf("Unable to create API client: %s", err)
+	}
+
+	ctx := context.Background()
+	defer client.AaaLogout(ctx)
+
+	// Retrieve the `sys` DN every hour, without having to take care of the session.
+	ticker := time.NewTicker(1 * time.Hour)
+	for range ticker.C {
+		req := api.ConfigResolveDnRequest{
+			Dn:             "sys",
+			InHierarchical: "false",
+		}
+
+		var sys mo.TopSystem
+		if err := client.ConfigResolveDn(ctx, req, &sys); err != nil {
+			log.Printf("Unable to retrieve DN: %s\n", err)
+			continue
+		}
+
+		log.Printf("%s is up for %s\n", sys.Name, sys.SystemUptime)
+	}
+}
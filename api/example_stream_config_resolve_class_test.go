@@ -0,0 +1,71 @@
+package api_test
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net/http"
+
+	"github.com/dnaeon/go-ucs/api"
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func Example_streamConfigResolveClass() {
+	// The following example shows how to process a large number of managed objects
+	// one at a time as they are read from the response, instead of loading all
+	// of them in memory first.
+
+	// Skip SSL certificate verification of remote endpoint.
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	httpClient := &http.Client{Transport: tr}
+
+	// Create a new Cisco UCS API client
+	config := api.Config{
+		Endpoint:   "https://ucs01.example.org/",
+		Username:   "admin",
+		Password:   "password",
+		HttpClient: httpClient,
+	}
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		log.Fatalf("Unable to create API client: %s", err)
+	}
+
+	ctx := context.Background()
+
+	log.Printf("Logging in to %s\n", config.Endpoint)
+	if _, err := client.AaaLogin(ctx); err != nil {
+		log.Fatalf("Unable to login: %s\n", err)
+	}
+	defer client.AaaLogout(ctx)
+
+	req := api.ConfigResolveClassRequest{
+		ClassId:        "computeBlade",
+		InHierarchical: "true",
+	}
+
+	// Our function is called for each managed object in the response.
+	// The managed object is decoded into the concrete type, which
+	// can be garbage collected once we are done with it.
+	fn := func(obj *api.Object) error {
+		var blade mo.ComputeBlade
+		if err := obj.Decode(&blade); err != nil {
+			return err
+		}
+
+		log.Printf("%s:\n", blade.Dn)
+		log.Printf("\tNumber of CPUs: %d\n", blade.NumOfCpus)
+		log.Printf("\tTotal Memory: %d\n", blade.TotalMemory)
+		log.Printf("\tNumber of Memory Units: %d\n", len(blade.ComputeBoard.MemoryArray.Units))
+
+		return nil
+	}
+
+	log.Println("Retrieving managed objects with class `computeBlade`")
+	if err := client.StreamConfigResolveClass(ctx, req, fn); err != nil {
+		log.Fatalf("Unable to retrieve `computeBlade` managed objects: %s", err)
+	}
+}
@@ -0,0 +1,81 @@
+package api_test
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+
+	"github.com/dnaeon/go-ucs/api"
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func Example_subscribe() {
+	// The following example shows how to receive event notifications
+	// about changes of compute blades until interrupted.
+
+	// Skip SSL certificate verification of remote endpoint.
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	httpClient := &http.Client{Transport: tr}
+
+	// Create a new Cisco UCS API client
+	config := api.Config{
+		Endpoint:   "https://ucs01.example.org/",
+		Username:   "admin",
+		Password:   "password",
+		HttpClient: httpClient,
+	}
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		log.Fatalf("Unable to create API client: %s", err)
+	}
+
+	ctx := context.Background()
+
+	log.Printf("Logging in to %s\n", config.Endpoint)
+	if _, err := client.AaaLogin(ctx); err != nil {
+		log.Fatalf("Unable to login: %s\n", err)
+	}
+	defer client.AaaLogout(ctx)
+
+	log.Printf("Got authentication cookie: %s\n", client.Cookie())
+
+	// Cancel the subscription when interrupted
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+
+	sub := client.Subscribe(ctx)
+	for event := range sub.Events() {
+		if event.Class != "computeBlade" {
+			continue
+		}
+
+		if event.Status == mo.StatusDeleted {
+			log.Printf("%s was removed\n", event.Dn)
+			continue
+		}
+
+		var blade mo.ComputeBlade
+		if err := event.Decode(&blade); err != nil {
+			log.Fatalf("Unable to decode event: %s", err)
+		}
+
+		if blade.OperationalState != "" {
+			log.Printf("%s is now %s\n", event.Dn, blade.OperationalState)
+		}
+	}
+
+	log.Printf("Subscription ended: %s\n", sub.Err())
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// FaultFilter specifies which faults are retrieved by ListFaults and FaultsOf.
+// The zero value retrieves all faults.
+type FaultFilter struct {
+	// Severities limits the faults to the given severities, e.g. mo.FaultSeverityCritical.
+	Severities []string
+
+	// Causes limits the faults to the given causes, e.g. equipment-inoperable.
+	Causes []string
+
+	// Unacknowledged limits the faults to the ones which were not acknowledged yet.
+	Unacknowledged bool
+}
+
+// filter returns the filter matching the faults specified by the FaultFilter
+// or nil if all faults are matched.
+func (f FaultFilter) filter() FilterAny {
+	var ack FilterAny
+	if f.Unacknowledged {
+		ack = eqFilter("faultInst", "ack", "no")
+	}
+
+	return andFilter(
+		eqFilter("faultInst", "severity", f.Severities...),
+		eqFilter("faultInst", "cause", f.Causes...),
+		ack,
+	)
+}
+
+// faults is the type into which the retrieved faults are unmarshaled.
+type faults struct {
+	XMLName xml.Name
+	Faults  []mo.FaultInst `xml:"faultInst"`
+}
+
+// ListFaults retrieves the faults matching the given filter.
+func (c *Client) ListFaults(ctx context.Context, filter FaultFilter) ([]mo.FaultInst, error) {
+	req := ConfigResolveClassRequest{
+		ClassId:        "faultInst",
+		InHierarchical: "false",
+		InFilter:       filter.filter(),
+	}
+
+	var out faults
+	if err := c.ConfigResolveClass(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Faults, nil
+}
+
+// FaultsOf retrieves the faults matching the given filter, which affect the managed
+// object with the given DN or any of the managed objects contained within it.
+func (c *Client) FaultsOf(ctx context.Context, dn string, filter FaultFilter) ([]mo.FaultInst, error) {
+	req := ConfigScopeRequest{
+		Dn:             dn,
+		InClass:        "faultInst",
+		InHierarchical: "false",
+		InRecursive:    "true",
+		InFilter:       filter.filter(),
+	}
+
+	var out faults
+	if err := c.ConfigScope(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Faults, nil
+}
+
+// AcknowledgeFaults acknowledges the faults with the given DNs in a single transaction.
+// Faults which are already cleared are deleted by the remote endpoint once acknowledged.
+func (c *Client) AcknowledgeFaults(ctx context.Context, dns ...string) error {
+	if len(dns) == 0 {
+		return nil
+	}
+
+	req := ConfigConfMosRequest{
+		InHierarchical: "false",
+	}
+
+	for _, dn := range dns {
+		fault := mo.FaultInst{
+			Dn:     dn,
+			Ack:    "yes",
+			Status: mo.StatusModified,
+		}
+		req.InConfigs = append(req.InConfigs, NewPair(dn, fault))
+	}
+
+	_, err := c.ConfigConfMos(ctx, req)
+
+	return err
+}
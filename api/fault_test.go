@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func TestFaults(t *testing.T) {
+	bodies := make(map[string]string)
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveClass": func(req testRequest) string {
+			bodies[req.Method] = string(req.Body)
+			return `<configResolveClass response="yes" classId="faultInst"><outConfigs>` +
+				`<faultInst dn="sys/chassis-1/blade-1/fault-F0283" ack="no" severity="critical" cause="equipment-inoperable" code="F0283" occur="3"/>` +
+				`<faultInst dn="org-root/ls-[web/01]/fault-F0327" ack="no" severity="major" cause="configuration-failure" code="F0327" occur="1"/>` +
+				`</outConfigs></configResolveClass>`
+		},
+		"configScope": func(req testRequest) string {
+			bodies[req.Method] = string(req.Body)
+			return `<configScope dn="sys/chassis-1" response="yes"><outConfigs>` +
+				`<faultInst dn="sys/chassis-1/blade-1/fault-F0283" ack="no" severity="critical" cause="equipment-inoperable" code="F0283"/>` +
+				`</outConfigs></configScope>`
+		},
+		"configConfMos": func(req testRequest) string {
+			bodies[req.Method] = string(req.Body)
+			return `<configConfMos response="yes"><outConfigs>` +
+				`<pair key="sys/chassis-1/blade-1/fault-F0283"><faultInst dn="sys/chassis-1/blade-1/fault-F0283" ack="yes"/></pair>` +
+				`</outConfigs></configConfMos>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	filter := FaultFilter{
+		Severities:     []string{mo.FaultSeverityCritical, mo.FaultSeverityMajor},
+		Unacknowledged: true,
+	}
+
+	faults, err := client.ListFaults(ctx, filter)
+	if err != nil {
+		t.Fatalf("Cannot list faults: %s", err)
+	}
+
+	expect := `<configResolveClass cookie="" classId="faultInst" inHierarchical="false"><inFilter><and>` +
+		`<or><eq class="faultInst" property="severity" value="critical"/><eq class="faultInst" property="severity" value="major"/></or>` +
+		`<eq class="faultInst" property="ack" value="no"/>` +
+		`</and></inFilter></configResolveClass>`
+	if bodies["configResolveClass"] != expect {
+		t.Fatalf("Got request '%s', expect '%s'", bodies["configResolveClass"], expect)
+	}
+
+	if len(faults) != 2 || faults[0].Code != "F0283" || faults[0].Occur != 3 {
+		t.Fatalf("Got faults %+v", faults)
+	}
+
+	if got := faults[0].AffectedDn(); got != "sys/chassis-1/blade-1" {
+		t.Fatalf("Got affected DN %q, expect %q", got, "sys/chassis-1/blade-1")
+	}
+
+	if got := faults[1].AffectedDn(); got != "org-root/ls-[web/01]" {
+		t.Fatalf("Got affected DN %q, expect %q", got, "org-root/ls-[web/01]")
+	}
+
+	faults, err = client.FaultsOf(ctx, "sys/chassis-1", FaultFilter{Causes: []string{"equipment-inoperable"}})
+	if err != nil {
+		t.Fatalf("Cannot retrieve faults: %s", err)
+	}
+
+	expect = `<configScope cookie="" dn="sys/chassis-1" inClass="faultInst" inHierarchical="false" inRecursive="true"><inFilter>` +
+		`<eq class="faultInst" property="cause" value="equipment-inoperable"/>` +
+		`</inFilter></configScope>`
+	if bodies["configScope"] != expect {
+		t.Fatalf("Got request '%s', expect '%s'", bodies["configScope"], expect)
+	}
+
+	if len(faults) != 1 {
+		t.Fatalf("Got %d faults, expect 1", len(faults))
+	}
+
+	if err := client.AcknowledgeFaults(ctx, faults[0].Dn); err != nil {
+		t.Fatalf("Cannot acknowledge fault: %s", err)
+	}
+
+	expect = `<configConfMos cookie="" inHierarchical="false"><inConfigs>` +
+		`<pair key="sys/chassis-1/blade-1/fault-F0283"><faultInst ack="yes" dn="sys/chassis-1/blade-1/fault-F0283" status="modified"/></pair>` +
+		`</inConfigs></configConfMos>`
+	if bodies["configConfMos"] != expect {
+		t.Fatalf("Got request '%s', expect '%s'", bodies["configConfMos"], expect)
+	}
+}
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// The default interval between two polls of a managed object while waiting for its FSM.
+const defaultFsmPollInterval = 5 * time.Second
+
+// FsmProgressFunc is called by WaitForFsm whenever the stage or the progress
+// of the FSM of a managed object changes.
+type FsmProgressFunc func(task mo.FiniteStateMachineTask)
+
+// FsmError is returned when the FSM of a managed object fails or is throttled.
+type FsmError struct {
+	// Dn is the DN of the managed object.
+	Dn string
+
+	// Task is the state of the FSM when it failed, including the
+	// error code and description of the failed remote invocation.
+	Task mo.FiniteStateMachineTask
+}
+
+// Error implements the error interface.
+func (e *FsmError) Error() string {
+	msg := fmt.Sprintf("%s: FSM %s at stage %q", e.Dn, fsmStatus(e.Task), e.Task.FsmStageDescription)
+	if e.Task.FsmRemoteInvErrCode != "" && e.Task.FsmRemoteInvErrCode != "none" {
+		msg += fmt.Sprintf(": %s: %s (code %s)", e.Task.FsmRemoteInvErrDescription, e.Task.FsmRemoteInvResult, e.Task.FsmRemoteInvErrCode)
+	}
+
+	return msg
+}
+
+// fsmStatus returns the status of the FSM of the given task. An FSM which is
+// not running reports the status of its previous run.
+func fsmStatus(task mo.FiniteStateMachineTask) string {
+	if task.FsmStatus == "" || task.FsmStatus == "nop" {
+		return task.FsmPrev
+	}
+
+	return task.FsmStatus
+}
+
+// fsmRunning returns a boolean indicating whether the FSM of the given task is running.
+func fsmRunning(task mo.FiniteStateMachineTask) bool {
+	return task.FsmStatus != "" && task.FsmStatus != "nop"
+}
+
+// fsmStarted returns a boolean indicating whether a new run of the FSM has been
+// seen in the given task since the given state, in which polling started. That is
+// the case if the FSM is running or if its timestamp or previous status has changed.
+func fsmStarted(start, task mo.FiniteStateMachineTask) bool {
+	return fsmRunning(task) || task.FsmTimestamp != start.FsmTimestamp || task.FsmPrev != start.FsmPrev
+}
+
+// fsmDone returns a boolean indicating whether the FSM of the given task has
+// reached a terminal state. If the FSM has failed or was throttled an
+// *FsmError is returned as well.
+func fsmDone(dn string, task mo.FiniteStateMachineTask) (bool, error) {
+	status := fsmStatus(task)
+
+	switch {
+	case strings.HasSuffix(status, "Success"):
+		return true, nil
+	case strings.HasSuffix(status, "Fail"), strings.HasSuffix(status, "Throttled"):
+		return true, &FsmError{Dn: dn, Task: task}
+	}
+
+	// An FSM which is not running and has never run is done as well.
+	return task.FsmStatus == "nop", nil
+}
+
+// fsmObject is the type into which managed objects are unmarshaled while polling their FSM.
+type fsmObject struct {
+	XMLName     xml.Name
+	Association string `xml:"association,attr"`
+	mo.FiniteStateMachineTask
+}
+
+// fsmDoneFunc returns a boolean indicating whether polling of the given
+// managed object is done and the error, if any, which polling should return.
+type fsmDoneFunc func(obj fsmObject) (bool, error)
+
+// FsmState returns the current state of the FSM of the managed object with the
+// given DN. It is meant to be passed as the baseline to WaitForFsm or
+// WaitForAssociation, when retrieved before starting the operation to wait for.
+func (c *Client) FsmState(ctx context.Context, dn string) (*mo.FiniteStateMachineTask, error) {
+	var out fsmObject
+	if err := c.resolveDn(ctx, dn, &out); err != nil {
+		return nil, err
+	}
+
+	return &out.FiniteStateMachineTask, nil
+}
+
+// WaitForFsm polls the managed object with the given DN using configResolveDn
+// until its FSM reaches a terminal state, which is either success, failure or
+// being throttled. The given function, if not nil, is called whenever the stage
+// or the progress of the FSM changes. If interval is zero, the managed object
+// is polled every 5 seconds.
+//
+// The remote API endpoint starts the FSM asynchronously, so the result of a
+// previous run is reported until the FSM starts. WaitForFsm therefore accepts
+// a terminal state only once a new run of the FSM has been seen since the given
+// baseline, which should be retrieved using FsmState before the operation
+// starting the FSM. If the baseline is nil, the state of the FSM when polling
+// starts is used instead, in which case a run of the FSM completing before the
+// first poll is missed. If the operation does not start the FSM, WaitForFsm
+// waits until the context is done.
+//
+// The final state of the FSM is returned. If the FSM has failed or was
+// throttled the returned error is an *FsmError.
+func (c *Client) WaitForFsm(ctx context.Context, dn string, baseline *mo.FiniteStateMachineTask, interval time.Duration, progress FsmProgressFunc) (*mo.FiniteStateMachineTask, error) {
+	done := func(obj fsmObject) (bool, error) {
+		return fsmDone(dn, obj.FiniteStateMachineTask)
+	}
+
+	return c.pollFsm(ctx, dn, baseline, interval, progress, done)
+}
+
+// pollFsm polls the managed object with the given DN until the given function
+// reports that polling is done, reporting the FSM progress along the way.
+// The given function is called only once a new run of the FSM has been seen
+// since the given baseline, or since the first poll if the baseline is nil.
+func (c *Client) pollFsm(ctx context.Context, dn string, baseline *mo.FiniteStateMachineTask, interval time.Duration, progress FsmProgressFunc, done fsmDoneFunc) (*mo.FiniteStateMachineTask, error) {
+	if interval <= 0 {
+		interval = defaultFsmPollInterval
+	}
+
+	start := baseline
+	var last *mo.FiniteStateMachineTask
+	started := false
+	for {
+		var out fsmObject
+		if err := c.resolveDn(ctx, dn, &out); err != nil {
+			return last, err
+		}
+
+		task := out.FiniteStateMachineTask
+		if start == nil {
+			start = &task
+		}
+		started = started || fsmStarted(*start, task)
+
+		changed := last == nil || task.FsmStageDescription != last.FsmStageDescription || task.FsmProgress != last.FsmProgress
+		last = &task
+
+		if progress != nil && changed {
+			progress(task)
+		}
+
+		// Until the FSM starts it reports the result of its previous run.
+		if started {
+			if ok, err := done(out); ok {
+				return last, err
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func TestWaitForFsm(t *testing.T) {
+	states := map[string][]string{
+		"sys/chassis-1/blade-1": {
+			`fsmStatus="DiscoverBegin" fsmProgr="0" fsmStageDescr="" fsmRmtInvErrCode="none"`,
+			`fsmStatus="DiscoverExecute" fsmProgr="40" fsmStageDescr="Waiting for BIOS POST completion" fsmRmtInvErrCode="none"`,
+			`fsmStatus="DiscoverExecute" fsmProgr="40" fsmStageDescr="Waiting for BIOS POST completion" fsmRmtInvErrCode="none"`,
+			`fsmStatus="nop" fsmPrev="DiscoverSuccess" fsmProgr="100" fsmStageDescr="" fsmRmtInvErrCode="none"`,
+		},
+		"sys/chassis-1/blade-4": {
+			`fsmStatus="nop" fsmPrev="DiscoverFail" fsmStamp="2026-10-17T10:00:00.000" fsmRmtInvErrCode="ERR-DNLD-no-file"`,
+			`fsmStatus="nop" fsmPrev="DiscoverFail" fsmStamp="2026-10-17T10:00:00.000" fsmRmtInvErrCode="ERR-DNLD-no-file"`,
+			`fsmStatus="DiscoverExecute" fsmProgr="40" fsmStamp="2026-10-17T11:00:00.000" fsmRmtInvErrCode="none"`,
+			`fsmStatus="nop" fsmPrev="DiscoverSuccess" fsmProgr="100" fsmStamp="2026-10-17T11:05:00.000" fsmRmtInvErrCode="none"`,
+		},
+		"sys/chassis-1/blade-5": {
+			`fsmStatus="nop" fsmPrev="DiscoverSuccess" fsmStamp="2026-10-17T10:00:00.000"`,
+			`fsmStatus="nop" fsmPrev="DiscoverSuccess" fsmStamp="2026-10-17T11:00:00.000"`,
+		},
+		"sys/chassis-1/blade-2": {
+			`fsmStatus="DiscoverExecute" fsmProgr="20" fsmStageDescr="Configure primary fabric interconnect" fsmRmtInvErrCode="none"`,
+			`fsmStatus="DiscoverFail" fsmProgr="20" fsmStageDescr="Configure primary fabric interconnect" fsmRmtInvErrCode="ERR-DNLD-no-file" fsmRmtInvErrDescr="Image not found" fsmRmtInvRslt="end-point-failed"`,
+		},
+	}
+
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveDn": func(req testRequest) string {
+			dn := req.Attrs["dn"]
+			polls, ok := states[dn]
+			if !ok {
+				return `<configResolveDn dn="` + dn + `" response="yes"><outConfig></outConfig></configResolveDn>`
+			}
+
+			state := polls[0]
+			if len(polls) > 1 {
+				states[dn] = polls[1:]
+			}
+
+			return `<configResolveDn dn="` + dn + `" response="yes"><outConfig><computeBlade dn="` + dn + `" ` + state + `/></outConfig></configResolveDn>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	var stages []string
+	progress := func(task mo.FiniteStateMachineTask) {
+		stages = append(stages, task.FsmStageDescription)
+	}
+
+	task, err := client.WaitForFsm(ctx, "sys/chassis-1/blade-1", nil, time.Millisecond, progress)
+	if err != nil {
+		t.Fatalf("Cannot wait for FSM: %s", err)
+	}
+
+	if task.FsmPrev != "DiscoverSuccess" || task.FsmProgress != 100 {
+		t.Fatalf("Got final state %s with progress %d", task.FsmPrev, task.FsmProgress)
+	}
+
+	if len(stages) != 3 || stages[1] != "Waiting for BIOS POST completion" {
+		t.Fatalf("Got progress updates %q", stages)
+	}
+
+	_, err = client.WaitForFsm(ctx, "sys/chassis-1/blade-2", nil, time.Millisecond, nil)
+	var fsmErr *FsmError
+	if !errors.As(err, &fsmErr) {
+		t.Fatalf("Got error %v, expect *FsmError", err)
+	}
+
+	if fsmErr.Dn != "sys/chassis-1/blade-2" || fsmErr.Task.FsmRemoteInvErrCode != "ERR-DNLD-no-file" {
+		t.Fatalf("Got FSM error %+v", fsmErr)
+	}
+
+	expect := `sys/chassis-1/blade-2: FSM DiscoverFail at stage "Configure primary fabric interconnect": Image not found: end-point-failed (code ERR-DNLD-no-file)`
+	if fsmErr.Error() != expect {
+		t.Fatalf("Got error %q, expect %q", fsmErr.Error(), expect)
+	}
+
+	// The result of a previous run is not reported, even if the FSM starts
+	// only after a few polls or completes a whole run between two polls.
+	task, err = client.WaitForFsm(ctx, "sys/chassis-1/blade-4", nil, time.Millisecond, nil)
+	if err != nil {
+		t.Fatalf("Got error %v for a new run after a failed one", err)
+	}
+
+	if task.FsmPrev != "DiscoverSuccess" || task.FsmTimestamp != "2026-10-17T11:05:00.000" {
+		t.Fatalf("Got final state %+v", task)
+	}
+
+	task, err = client.WaitForFsm(ctx, "sys/chassis-1/blade-5", nil, time.Millisecond, nil)
+	if err != nil || task.FsmTimestamp != "2026-10-17T11:00:00.000" {
+		t.Fatalf("Got final state %+v with error %v", task, err)
+	}
+
+	if _, err := client.WaitForFsm(ctx, "sys/chassis-1/blade-3", nil, time.Millisecond, nil); !IsObjectNotFound(err) {
+		t.Fatalf("Got error %v, expect object not found", err)
+	}
+
+	// The FSM of the first blade is no longer changing and the FSM of the
+	// second blade never starts, so we should time out in both cases.
+	states["sys/chassis-1/blade-1"] = []string{`fsmStatus="DiscoverExecute" fsmProgr="40"`}
+	states["sys/chassis-1/blade-2"] = []string{`fsmStatus="nop" fsmPrev="DiscoverFail" fsmStamp="2026-10-17T10:00:00.000"`}
+	for _, dn := range []string{"sys/chassis-1/blade-1", "sys/chassis-1/blade-2"} {
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		_, err := client.WaitForFsm(ctx, dn, nil, 10*time.Millisecond, nil)
+		cancel()
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Got error %v for %s, expect %v", err, dn, context.DeadlineExceeded)
+		}
+	}
+}
+
+func TestWaitForFsmBaseline(t *testing.T) {
+	// The FSM completes a whole run between retrieving the
+	// baseline and the first poll of WaitForFsm.
+	states := []string{
+		`fsmStatus="nop" fsmPrev="DiscoverFail" fsmStamp="2026-10-17T10:00:00.000" fsmRmtInvErrCode="ERR-DNLD-no-file"`,
+		`fsmStatus="nop" fsmPrev="DiscoverSuccess" fsmProgr="100" fsmStamp="2026-10-17T11:00:00.000"`,
+	}
+
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveDn": func(req testRequest) string {
+			state := states[0]
+			if len(states) > 1 {
+				states = states[1:]
+			}
+
+			return `<configResolveDn dn="sys/chassis-1/blade-1" response="yes"><outConfig><computeBlade dn="sys/chassis-1/blade-1" ` + state + `/></outConfig></configResolveDn>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	baseline, err := client.FsmState(ctx, "sys/chassis-1/blade-1")
+	if err != nil {
+		t.Fatalf("Cannot retrieve FSM state: %s", err)
+	}
+
+	if baseline.FsmPrev != "DiscoverFail" {
+		t.Fatalf("Got baseline %+v", baseline)
+	}
+
+	task, err := client.WaitForFsm(ctx, "sys/chassis-1/blade-1", baseline, time.Millisecond, nil)
+	if err != nil {
+		t.Fatalf("Cannot wait for FSM: %s", err)
+	}
+
+	if task.FsmPrev != "DiscoverSuccess" || task.FsmTimestamp != "2026-10-17T11:00:00.000" {
+		t.Fatalf("Got final state %+v", task)
+	}
+
+	if n := ts.Calls("configResolveDn"); n != 2 {
+		t.Fatalf("Got %d configResolveDn requests, expect 2", n)
+	}
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// RootOrgDn is the DN of the root organization.
+const RootOrgDn = "org-root"
+
+// OrgOf returns the DN of the organization containing the managed object with the
+// given DN, e.g. "org-root/org-web" for "org-root/org-web/ls-web01". The DN of an
+// organization is returned as is. If the managed object is not contained within
+// an organization, e.g. a physical server, an empty string is returned.
+func OrgOf(dn string) string {
+	var orgs []string
+	for _, rn := range mo.SplitDn(dn) {
+		if !strings.HasPrefix(rn, "org-") {
+			break
+		}
+		orgs = append(orgs, rn)
+	}
+
+	return strings.Join(orgs, "/")
+}
+
+// OrgTree retrieves all organizations and returns the root organization
+// with its sub-organizations. Other children of the organizations,
+// e.g. service profiles, are not retrieved.
+func (c *Client) OrgTree(ctx context.Context) (*mo.OrgOrg, error) {
+	req := ConfigResolveClassRequest{
+		ClassId:        "orgOrg",
+		InHierarchical: "false",
+	}
+
+	var out struct {
+		XMLName xml.Name
+		Orgs    []mo.OrgOrg `xml:"orgOrg"`
+	}
+
+	if err := c.ConfigResolveClass(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	var root *mo.OrgOrg
+	children := make(map[string][]mo.OrgOrg)
+	for i, org := range out.Orgs {
+		if org.Dn == RootOrgDn {
+			root = &out.Orgs[i]
+			continue
+		}
+
+		parent := mo.ParentDn(org.Dn)
+		children[parent] = append(children[parent], org)
+	}
+
+	if root == nil {
+		err := &Error{
+			Method:      "configResolveClass",
+			Code:        ErrorCodeObjectNotFound,
+			Description: fmt.Sprintf("managed object %s not found", RootOrgDn),
+		}
+		return nil, err
+	}
+
+	tree := buildOrgTree(*root, children)
+
+	return &tree, nil
+}
+
+// buildOrgTree adds the sub-organizations to the given organization recursively.
+func buildOrgTree(org mo.OrgOrg, children map[string][]mo.OrgOrg) mo.OrgOrg {
+	for _, child := range children[org.Dn] {
+		org.Orgs = append(org.Orgs, buildOrgTree(child, children))
+	}
+
+	return org
+}
+
+// CreateOrg creates a sub-organization with the given name and description
+// within the organization with the given DN. The created organization is returned.
+func (c *Client) CreateOrg(ctx context.Context, parentDn, name, description string) (*mo.OrgOrg, error) {
+	org := mo.OrgOrg{
+		Dn:          parentDn + "/org-" + name,
+		Name:        name,
+		Description: description,
+		Status:      mo.StatusCreated,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             org.Dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: org},
+	}
+
+	var out mo.OrgOrg
+	if err := c.ConfigConfMo(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// DeleteOrg deletes the organization with the given DN including everything
+// contained within it, e.g. its sub-organizations, policies and service profiles.
+func (c *Client) DeleteOrg(ctx context.Context, dn string) error {
+	org := mo.OrgOrg{
+		Dn:     dn,
+		Status: mo.StatusDeleted,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: org},
+	}
+
+	return c.ConfigConfMo(ctx, req, nil)
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOrgOf(t *testing.T) {
+	tests := []struct {
+		dn   string
+		want string
+	}{
+		{"org-root", "org-root"},
+		{"org-root/org-web", "org-root/org-web"},
+		{"org-root/org-web/ls-web01", "org-root/org-web"},
+		{"org-root/org-web/org-prod/ls-[web/01]/ether-eth0", "org-root/org-web/org-prod"},
+		{"org-root/org-web/ls-org-test", "org-root/org-web"},
+		{"sys/chassis-1/blade-1", ""},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		if got := OrgOf(test.dn); got != test.want {
+			t.Errorf("OrgOf(%q) = %q, want %q", test.dn, got, test.want)
+		}
+	}
+}
+
+func TestOrgs(t *testing.T) {
+	var confMos []string
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveClass": func(req testRequest) string {
+			return `<configResolveClass response="yes" classId="orgOrg"><outConfigs>` +
+				`<orgOrg dn="org-root/org-web/org-prod" name="prod"/>` +
+				`<orgOrg dn="org-root" name="root"/>` +
+				`<orgOrg dn="org-root/org-db" name="db"/>` +
+				`<orgOrg dn="org-root/org-web" name="web"/>` +
+				`</outConfigs></configResolveClass>`
+		},
+		"configConfMo": func(req testRequest) string {
+			confMos = append(confMos, string(req.Body))
+			return `<configConfMo dn="` + req.Attrs["dn"] + `" response="yes"><outConfig>` +
+				`<orgOrg dn="org-root/org-web/org-test" name="test" descr="Test systems" level="2"/>` +
+				`</outConfig></configConfMo>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	root, err := client.OrgTree(ctx)
+	if err != nil {
+		t.Fatalf("Cannot retrieve organizations: %s", err)
+	}
+
+	if root.Dn != "org-root" || len(root.Orgs) != 2 {
+		t.Fatalf("Got root organization %s with %d sub-organizations", root.Dn, len(root.Orgs))
+	}
+
+	web := root.Orgs[1]
+	if web.Dn != "org-root/org-web" || len(web.Orgs) != 1 || web.Orgs[0].Name != "prod" {
+		t.Fatalf("Got organization %+v", web)
+	}
+
+	org, err := client.CreateOrg(ctx, "org-root/org-web", "test", "Test systems")
+	if err != nil {
+		t.Fatalf("Cannot create organization: %s", err)
+	}
+
+	if org.Dn != "org-root/org-web/org-test" || org.Level != "2" {
+		t.Fatalf("Got organization %+v", org)
+	}
+
+	if err := client.DeleteOrg(ctx, org.Dn); err != nil {
+		t.Fatalf("Cannot delete organization: %s", err)
+	}
+
+	expect := []string{
+		`<configConfMo cookie="" dn="org-root/org-web/org-test" inHierarchical="false"><inConfig>` +
+			`<orgOrg descr="Test systems" dn="org-root/org-web/org-test" name="test" status="created"/>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="org-root/org-web/org-test" inHierarchical="false"><inConfig>` +
+			`<orgOrg dn="org-root/org-web/org-test" status="deleted"/>` +
+			`</inConfig></configConfMo>`,
+	}
+
+	if len(confMos) != len(expect) {
+		t.Fatalf("Got %d configConfMo requests, expect %d", len(confMos), len(expect))
+	}
+
+	for i := range expect {
+		if confMos[i] != expect[i] {
+			t.Fatalf("Got request '%s', expect '%s'", confMos[i], expect[i])
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// poolClasses contains the classes of the identity pools, the classes of the identities
+// contained within them and the types into which each of them is unmarshaled.
+var poolClasses = []struct {
+	pool      string
+	pooled    string
+	newPools  func() poolList
+	newPooled func() pooledList
+}{
+	{"macpoolPool", "macpoolPooled", func() poolList { return &macPools{} }, func() pooledList { return &macPooled{} }},
+	{"uuidpoolPool", "uuidpoolPooled", func() poolList { return &uuidPools{} }, func() pooledList { return &uuidPooled{} }},
+	{"fcpoolInitiators", "fcpoolInitiatorEp", func() poolList { return &wwnPools{} }, func() pooledList { return &wwnPooled{} }},
+	{"ippoolPool", "ippoolPooled", func() poolList { return &ipPools{} }, func() pooledList { return &ipPooled{} }},
+}
+
+// PoolUsage describes the usage of an identity pool, e.g. a MAC pool.
+type PoolUsage struct {
+	// Class is the class of the pool, e.g. macpoolPool.
+	Class string
+
+	// Dn is the DN of the pool.
+	Dn string
+
+	// Name is the name of the pool.
+	Name string
+
+	// Size is the number of identities in the pool.
+	Size int
+
+	// Assigned is the number of identities assigned from the pool.
+	Assigned int
+
+	// Free is the number of identities, which can still be assigned.
+	Free int
+
+	// Assignments maps each assigned identity, e.g. a MAC address,
+	// to the DN of the managed object it is assigned to.
+	Assignments map[string]string
+}
+
+// ListPoolUsage retrieves all MAC, UUID, WWN and IP pools and reports the usage of each pool.
+func (c *Client) ListPoolUsage(ctx context.Context) ([]PoolUsage, error) {
+	var usage []PoolUsage
+	for _, classes := range poolClasses {
+		pools := classes.newPools()
+		poolReq := ConfigResolveClassRequest{
+			ClassId:        classes.pool,
+			InHierarchical: "false",
+		}
+
+		if err := c.ConfigResolveClass(ctx, poolReq, pools); err != nil {
+			return nil, err
+		}
+
+		pooled := classes.newPooled()
+		pooledReq := ConfigResolveClassRequest{
+			ClassId:        classes.pooled,
+			InHierarchical: "false",
+			InFilter:       eqFilter(classes.pooled, "assigned", "yes"),
+		}
+
+		if err := c.ConfigResolveClass(ctx, pooledReq, pooled); err != nil {
+			return nil, err
+		}
+
+		usage = append(usage, poolUsage(classes.pool, pools.pools(), pooled.pooled())...)
+	}
+
+	return usage, nil
+}
+
+// pool contains the properties of an identity pool, which make up its usage.
+type pool struct {
+	dn       string
+	name     string
+	size     int
+	assigned int
+}
+
+// pooled contains the properties of an identity assigned from an identity pool.
+type pooled struct {
+	dn           string
+	id           string
+	assignedToDn string
+}
+
+// poolList is implemented by the types into which the identity pools of a class are unmarshaled.
+type poolList interface {
+	pools() []pool
+}
+
+// pooledList is implemented by the types into which the identities assigned
+// from the identity pools of a class are unmarshaled.
+type pooledList interface {
+	pooled() []pooled
+}
+
+// poolUsage returns the usage of the given pools of the given class, joining each
+// pool with the given assigned identities it contains.
+func poolUsage(class string, pools []pool, assigned []pooled) []PoolUsage {
+	assignments := make(map[string]map[string]string)
+	for _, p := range assigned {
+		poolDn := mo.ParentDn(p.dn)
+		if assignments[poolDn] == nil {
+			assignments[poolDn] = make(map[string]string)
+		}
+		assignments[poolDn][p.id] = p.assignedToDn
+	}
+
+	usage := make([]PoolUsage, 0, len(pools))
+	for _, p := range pools {
+		u := PoolUsage{
+			Class:       class,
+			Dn:          p.dn,
+			Name:        p.name,
+			Size:        p.size,
+			Assigned:    p.assigned,
+			Free:        p.size - p.assigned,
+			Assignments: assignments[p.dn],
+		}
+
+		if u.Assignments == nil {
+			u.Assignments = make(map[string]string)
+		}
+
+		usage = append(usage, u)
+	}
+
+	return usage
+}
+
+// macPools is the type into which MAC pools are unmarshaled.
+type macPools struct {
+	XMLName xml.Name
+	Pools   []mo.MacpoolPool `xml:"macpoolPool"`
+}
+
+// pools returns the properties of the MAC pools.
+func (l *macPools) pools() []pool {
+	out := make([]pool, 0, len(l.Pools))
+	for _, p := range l.Pools {
+		out = append(out, pool{dn: p.Dn, name: p.Name, size: p.Size, assigned: p.Assigned})
+	}
+
+	return out
+}
+
+// macPooled is the type into which assigned MAC addresses are unmarshaled.
+type macPooled struct {
+	XMLName xml.Name
+	Pooled  []mo.MacpoolPooled `xml:"macpoolPooled"`
+}
+
+// pooled returns the properties of the assigned MAC addresses.
+func (l *macPooled) pooled() []pooled {
+	out := make([]pooled, 0, len(l.Pooled))
+	for _, p := range l.Pooled {
+		out = append(out, pooled{dn: p.Dn, id: p.Id, assignedToDn: p.AssignedToDn})
+	}
+
+	return out
+}
+
+// uuidPools is the type into which UUID pools are unmarshaled.
+type uuidPools struct {
+	XMLName xml.Name
+	Pools   []mo.UuidpoolPool `xml:"uuidpoolPool"`
+}
+
+// pools returns the properties of the UUID pools.
+func (l *uuidPools) pools() []pool {
+	out := make([]pool, 0, len(l.Pools))
+	for _, p := range l.Pools {
+		out = append(out, pool{dn: p.Dn, name: p.Name, size: p.Size, assigned: p.Assigned})
+	}
+
+	return out
+}
+
+// uuidPooled is the type into which assigned UUID suffixes are unmarshaled.
+type uuidPooled struct {
+	XMLName xml.Name
+	Pooled  []mo.UuidpoolPooled `xml:"uuidpoolPooled"`
+}
+
+// pooled returns the properties of the assigned UUID suffixes.
+func (l *uuidPooled) pooled() []pooled {
+	out := make([]pooled, 0, len(l.Pooled))
+	for _, p := range l.Pooled {
+		out = append(out, pooled{dn: p.Dn, id: p.Id, assignedToDn: p.AssignedToDn})
+	}
+
+	return out
+}
+
+// wwnPools is the type into which WWN pools are unmarshaled.
+type wwnPools struct {
+	XMLName xml.Name
+	Pools   []mo.FcpoolInitiators `xml:"fcpoolInitiators"`
+}
+
+// pools returns the properties of the WWN pools.
+func (l *wwnPools) pools() []pool {
+	out := make([]pool, 0, len(l.Pools))
+	for _, p := range l.Pools {
+		out = append(out, pool{dn: p.Dn, name: p.Name, size: p.Size, assigned: p.Assigned})
+	}
+
+	return out
+}
+
+// wwnPooled is the type into which assigned WWNs are unmarshaled.
+type wwnPooled struct {
+	XMLName xml.Name
+	Pooled  []mo.FcpoolInitiatorEp `xml:"fcpoolInitiatorEp"`
+}
+
+// pooled returns the properties of the assigned WWNs.
+func (l *wwnPooled) pooled() []pooled {
+	out := make([]pooled, 0, len(l.Pooled))
+	for _, p := range l.Pooled {
+		out = append(out, pooled{dn: p.Dn, id: p.Id, assignedToDn: p.AssignedToDn})
+	}
+
+	return out
+}
+
+// ipPools is the type into which IP pools are unmarshaled.
+type ipPools struct {
+	XMLName xml.Name
+	Pools   []mo.IppoolPool `xml:"ippoolPool"`
+}
+
+// pools returns the properties of the IP pools.
+func (l *ipPools) pools() []pool {
+	out := make([]pool, 0, len(l.Pools))
+	for _, p := range l.Pools {
+		out = append(out, pool{dn: p.Dn, name: p.Name, size: p.Size, assigned: p.Assigned})
+	}
+
+	return out
+}
+
+// ipPooled is the type into which assigned IP addresses are unmarshaled.
+type ipPooled struct {
+	XMLName xml.Name
+	Pooled  []mo.IppoolPooled `xml:"ippoolPooled"`
+}
+
+// pooled returns the properties of the assigned IP addresses.
+func (l *ipPooled) pooled() []pooled {
+	out := make([]pooled, 0, len(l.Pooled))
+	for _, p := range l.Pooled {
+		out = append(out, pooled{dn: p.Dn, id: p.Id, assignedToDn: p.AssignedToDn})
+	}
+
+	return out
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestListPoolUsage(t *testing.T) {
+	outConfigs := map[string]string{
+		"macpoolPool": `<macpoolPool dn="org-root/mac-pool-default" name="default" size="256" assigned="2"/>` +
+			`<macpoolPool dn="org-root/org-web/mac-pool-web" name="web" size="16" assigned="16"/>`,
+		"macpoolPooled": `<macpoolPooled dn="org-root/mac-pool-default/mac-00:25:B5:00:00:00" id="00:25:B5:00:00:00" assigned="yes" assignedToDn="org-root/ls-web01/ether-eth0"/>` +
+			`<macpoolPooled dn="org-root/mac-pool-default/mac-00:25:B5:00:00:01" id="00:25:B5:00:00:01" assigned="yes" assignedToDn="org-root/ls-web01/ether-eth1"/>`,
+		"uuidpoolPool":   `<uuidpoolPool dn="org-root/uuid-pool-default" name="default" size="100" assigned="1"/>`,
+		"uuidpoolPooled": `<uuidpoolPooled dn="org-root/uuid-pool-default/uuid-0000-000000000001" id="0000-000000000001" assigned="yes" assignedToDn="org-root/ls-web01"/>`,
+		"fcpoolInitiators": `<fcpoolInitiators dn="org-root/wwn-pool-node-default" name="node-default" purpose="node-wwn-assignment" size="64" assigned="1"/>` +
+			`<fcpoolInitiators dn="org-root/wwn-pool-port-a" name="port-a" purpose="port-wwn-assignment" size="32" assigned="2"/>`,
+		"fcpoolInitiatorEp": `<fcpoolInitiatorEp dn="org-root/wwn-pool-node-default/initiator-20:00:00:25:B5:00:00:00" id="20:00:00:25:B5:00:00:00" assigned="yes" assignedToDn="org-root/ls-web01/fc-node"/>` +
+			`<fcpoolInitiatorEp dn="org-root/wwn-pool-port-a/initiator-20:00:00:25:B5:0A:00:00" id="20:00:00:25:B5:0A:00:00" assigned="yes" assignedToDn="org-root/ls-web01/fc-fc0"/>` +
+			`<fcpoolInitiatorEp dn="org-root/wwn-pool-port-a/initiator-20:00:00:25:B5:0A:00:01" id="20:00:00:25:B5:0A:00:01" assigned="yes" assignedToDn="org-root/ls-web02/fc-fc0"/>`,
+		"ippoolPool":   `<ippoolPool dn="org-root/ip-pool-ext-mgmt" name="ext-mgmt" size="8" assigned="1"/>`,
+		"ippoolPooled": `<ippoolPooled dn="org-root/ip-pool-ext-mgmt/ip-192.0.2.10" id="192.0.2.10" defGw="192.0.2.1" subnet="255.255.255.0" assigned="yes" assignedToDn="sys/chassis-1/blade-1/mgmt/ipv4-pooled-addr"/>`,
+	}
+
+	var filters []string
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveClass": func(req testRequest) string {
+			classId := req.Attrs["classId"]
+			if strings.Contains(string(req.Body), "<inFilter>") {
+				filters = append(filters, classId)
+			}
+			return `<configResolveClass response="yes" classId="` + classId + `"><outConfigs>` + outConfigs[classId] + `</outConfigs></configResolveClass>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	usage, err := client.ListPoolUsage(context.Background())
+	if err != nil {
+		t.Fatalf("Cannot retrieve pool usage: %s", err)
+	}
+
+	if len(usage) != 6 {
+		t.Fatalf("Got %d pools, expect 6", len(usage))
+	}
+
+	mac := usage[0]
+	if mac.Class != "macpoolPool" || mac.Size != 256 || mac.Assigned != 2 || mac.Free != 254 {
+		t.Fatalf("Got pool usage %+v", mac)
+	}
+
+	if got := mac.Assignments["00:25:B5:00:00:01"]; got != "org-root/ls-web01/ether-eth1" {
+		t.Fatalf("Got assignment %q, expect %q", got, "org-root/ls-web01/ether-eth1")
+	}
+
+	if web := usage[1]; web.Free != 0 || len(web.Assignments) != 0 {
+		t.Fatalf("Got pool usage %+v", web)
+	}
+
+	if uuid := usage[2]; uuid.Class != "uuidpoolPool" || uuid.Assignments["0000-000000000001"] != "org-root/ls-web01" {
+		t.Fatalf("Got pool usage %+v", uuid)
+	}
+
+	if node := usage[3]; node.Class != "fcpoolInitiators" || node.Free != 63 || node.Assignments["20:00:00:25:B5:00:00:00"] != "org-root/ls-web01/fc-node" {
+		t.Fatalf("Got pool usage %+v", node)
+	}
+
+	port := usage[4]
+	if port.Dn != "org-root/wwn-pool-port-a" || port.Free != 30 || len(port.Assignments) != 2 {
+		t.Fatalf("Got pool usage %+v", port)
+	}
+
+	if got := port.Assignments["20:00:00:25:B5:0A:00:01"]; got != "org-root/ls-web02/fc-fc0" {
+		t.Fatalf("Got assignment %q, expect %q", got, "org-root/ls-web02/fc-fc0")
+	}
+
+	if ip := usage[5]; ip.Class != "ippoolPool" || ip.Free != 7 || ip.Assignments["192.0.2.10"] != "sys/chassis-1/blade-1/mgmt/ipv4-pooled-addr" {
+		t.Fatalf("Got pool usage %+v", ip)
+	}
+
+	expect := "macpoolPooled uuidpoolPooled fcpoolInitiatorEp ippoolPooled"
+	if got := strings.Join(filters, " "); got != expect {
+		t.Fatalf("Got filtered requests for %q, expect %q", got, expect)
+	}
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// switchDn returns the DN of the fabric interconnect with the given switch id.
+func switchDn(switchId string) string {
+	return "sys/switch-" + switchId
+}
+
+// ListFabricPorts retrieves the physical Ethernet ports of the fabric interconnect
+// with the given switch id, e.g. the Id of a mo.NetworkElement. The role of each
+// port, e.g. mo.InterfaceRoleServer, is reported by its InterfaceRole field.
+func (c *Client) ListFabricPorts(ctx context.Context, switchId string) ([]mo.EtherPIo, error) {
+	req := ConfigScopeRequest{
+		Dn:             switchDn(switchId),
+		InClass:        "etherPIo",
+		InHierarchical: "false",
+		InRecursive:    "true",
+	}
+
+	var out struct {
+		XMLName xml.Name
+		Ports   []mo.EtherPIo `xml:"etherPIo"`
+	}
+
+	if err := c.ConfigScope(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Ports, nil
+}
+
+// PortRole is the role in which a fabric interconnect port is configured by ConfigurePort.
+type PortRole string
+
+// Roles in which a fabric interconnect port can be configured.
+const (
+	PortRoleServer    PortRole = "server"
+	PortRoleUplink    PortRole = "uplink"
+	PortRoleFcUplink  PortRole = "fc-uplink"
+	PortRoleAppliance PortRole = "appliance"
+)
+
+// portName returns the name of the port with the given slot and port id
+// as used within the RN of the endpoints configuring the port.
+func portName(slotId, portId int) string {
+	return fmt.Sprintf("slot-%d-port-%d", slotId, portId)
+}
+
+// portEndpoint returns the managed object configuring the port with the given slot
+// and port id of the fabric interconnect with the given switch id in the given role.
+func portEndpoint(role PortRole, switchId string, slotId, portId int, status string) (mo.Any, string, error) {
+	name := portName(slotId, portId)
+
+	switch role {
+	case PortRoleServer:
+		dn := "fabric/server/sw-" + switchId + "/" + name
+		ep := mo.FabricDceSwSrvEp{Dn: dn, SlotId: slotId, PortId: portId, Status: status}
+		return ep, dn, nil
+	case PortRoleUplink:
+		dn := LanCloudDn + "/" + switchId + "/phys-" + name
+		ep := mo.FabricEthLanEp{Dn: dn, SlotId: slotId, PortId: portId, Status: status}
+		return ep, dn, nil
+	case PortRoleFcUplink:
+		dn := SanCloudDn + "/" + switchId + "/phys-" + name
+		ep := mo.FabricFcSanEp{Dn: dn, SlotId: slotId, PortId: portId, Status: status}
+		return ep, dn, nil
+	case PortRoleAppliance:
+		dn := "fabric/eth-estc/" + switchId + "/phys-" + name
+		ep := mo.FabricEthEstcEp{Dn: dn, SlotId: slotId, PortId: portId, Status: status}
+		return ep, dn, nil
+	}
+
+	return nil, "", fmt.Errorf("unknown port role %q", role)
+}
+
+// ConfigurePort configures the port with the given slot and port id of the fabric
+// interconnect with the given switch id, e.g. the Id of a mo.NetworkElement, in the
+// given role. A port configured in another role has to be unconfigured first using
+// UnconfigurePort. The DN of the endpoint configuring the port is returned.
+func (c *Client) ConfigurePort(ctx context.Context, role PortRole, switchId string, slotId, portId int) (string, error) {
+	ep, dn, err := portEndpoint(role, switchId, slotId, portId, mo.StatusCreated)
+	if err != nil {
+		return "", err
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: ep},
+	}
+
+	if err := c.ConfigConfMo(ctx, req, nil); err != nil {
+		return "", err
+	}
+
+	return dn, nil
+}
+
+// UnconfigurePort removes the given role from the port with the given slot and port id
+// of the fabric interconnect with the given switch id, which leaves the port unconfigured.
+func (c *Client) UnconfigurePort(ctx context.Context, role PortRole, switchId string, slotId, portId int) error {
+	ep, dn, err := portEndpoint(role, switchId, slotId, portId, mo.StatusDeleted)
+	if err != nil {
+		return err
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: ep},
+	}
+
+	return c.ConfigConfMo(ctx, req, nil)
+}
+
+// portChannelMembers returns the given member ports of a port channel, which are
+// identified by their SlotId and PortId fields, with their RN and the given status set.
+func portChannelMembers(members []mo.FabricEthLanPcEp, status string) []mo.FabricEthLanPcEp {
+	eps := make([]mo.FabricEthLanPcEp, len(members))
+	for i, m := range members {
+		m.Rn = "ep-" + portName(m.SlotId, m.PortId)
+		m.Status = status
+		eps[i] = m
+	}
+
+	return eps
+}
+
+// ConfigurePortChannel creates the Ethernet uplink port channel with the given id and
+// name on the fabric interconnect with the given switch id, or modifies it if it exists
+// already, and adds the given member ports, which are identified by their SlotId and
+// PortId fields. The configured port channel is returned.
+func (c *Client) ConfigurePortChannel(ctx context.Context, switchId string, id int, name string, members ...mo.FabricEthLanPcEp) (*mo.FabricEthLanPc, error) {
+	pc := mo.FabricEthLanPc{
+		Dn:      fmt.Sprintf("%s/%s/pc-%d", LanCloudDn, switchId, id),
+		Name:    name,
+		PortId:  id,
+		Status:  mo.StatusCreatedModified,
+		Members: portChannelMembers(members, mo.StatusCreatedModified),
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             pc.Dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: pc},
+	}
+
+	var out mo.FabricEthLanPc
+	if err := c.ConfigConfMo(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// RemovePortChannelMembers removes the given member ports, which are identified by
+// their SlotId and PortId fields, from the port channel with the given DN.
+func (c *Client) RemovePortChannelMembers(ctx context.Context, dn string, members ...mo.FabricEthLanPcEp) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	pc := mo.FabricEthLanPc{
+		Dn:      dn,
+		Status:  mo.StatusModified,
+		Members: portChannelMembers(members, mo.StatusDeleted),
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: pc},
+	}
+
+	return c.ConfigConfMo(ctx, req, nil)
+}
+
+// DeletePortChannel deletes the port channel with the given DN, which leaves
+// its member ports unconfigured.
+func (c *Client) DeletePortChannel(ctx context.Context, dn string) error {
+	pc := mo.FabricEthLanPc{
+		Dn:     dn,
+		Status: mo.StatusDeleted,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: pc},
+	}
+
+	return c.ConfigConfMo(ctx, req, nil)
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func TestListFabricPorts(t *testing.T) {
+	var body string
+	ts := newTestServer(t, map[string]testHandler{
+		"configScope": func(req testRequest) string {
+			body = string(req.Body)
+			return `<configScope dn="sys/switch-B" response="yes"><outConfigs>` +
+				`<etherPIo dn="sys/switch-B/slot-1/switch-ether/port-1" portId="1" slotId="1" switchId="B" ifRole="server" operSpeed="10gbps" operState="up"/>` +
+				`<etherPIo dn="sys/switch-B/slot-1/switch-ether/port-17" portId="17" slotId="1" switchId="B" ifRole="network" operSpeed="40gbps" operState="up"/>` +
+				`<etherPIo dn="sys/switch-B/slot-1/switch-ether/port-32" portId="32" slotId="1" switchId="B" ifRole="unknown" operState="sfp-not-present"/>` +
+				`</outConfigs></configScope>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	ports, err := client.ListFabricPorts(context.Background(), mo.SwitchIdB)
+	if err != nil {
+		t.Fatalf("Cannot list fabric ports: %s", err)
+	}
+
+	expect := `<configScope cookie="" dn="sys/switch-B" inClass="etherPIo" inHierarchical="false" inRecursive="true"/>`
+	if body != expect {
+		t.Fatalf("Got request '%s', expect '%s'", body, expect)
+	}
+
+	if len(ports) != 3 {
+		t.Fatalf("Got %d ports, expect 3", len(ports))
+	}
+
+	roles := []string{mo.InterfaceRoleServer, mo.InterfaceRoleNetwork, mo.InterfaceRoleUnknown}
+	for i, role := range roles {
+		if ports[i].InterfaceRole != role {
+			t.Fatalf("Got port %s with role %q, expect %q", ports[i].Dn, ports[i].InterfaceRole, role)
+		}
+	}
+
+	if ports[1].PortId != 17 || ports[1].OperationalSpeed != "40gbps" {
+		t.Fatalf("Got port %+v", ports[1])
+	}
+}
+
+func TestConfigurePort(t *testing.T) {
+	var confMos []string
+	ts := newTestServer(t, map[string]testHandler{
+		"configConfMo": func(req testRequest) string {
+			confMos = append(confMos, string(req.Body))
+			return `<configConfMo dn="` + req.Attrs["dn"] + `" response="yes"><outConfig>` +
+				`<fabricEthLanPc dn="` + req.Attrs["dn"] + `" name="uplinks" portId="10" operState="up"/>` +
+				`</outConfig></configConfMo>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	roles := []PortRole{PortRoleServer, PortRoleUplink, PortRoleFcUplink, PortRoleAppliance}
+	dns := []string{
+		"fabric/server/sw-A/slot-1-port-1",
+		"fabric/lan/A/phys-slot-1-port-1",
+		"fabric/san/A/phys-slot-1-port-1",
+		"fabric/eth-estc/A/phys-slot-1-port-1",
+	}
+	for i, role := range roles {
+		dn, err := client.ConfigurePort(ctx, role, mo.SwitchIdA, 1, 1)
+		if err != nil {
+			t.Fatalf("Cannot configure %s port: %s", role, err)
+		}
+		if dn != dns[i] {
+			t.Fatalf("Got DN %s, expect %s", dn, dns[i])
+		}
+	}
+
+	if _, err := client.ConfigurePort(ctx, "bogus", mo.SwitchIdA, 1, 1); err == nil {
+		t.Fatalf("Expect error for unknown port role")
+	}
+
+	if err := client.UnconfigurePort(ctx, PortRoleServer, mo.SwitchIdB, 1, 5); err != nil {
+		t.Fatalf("Cannot unconfigure port: %s", err)
+	}
+
+	members := []mo.FabricEthLanPcEp{{SlotId: 1, PortId: 17}, {SlotId: 1, PortId: 18}}
+	pc, err := client.ConfigurePortChannel(ctx, mo.SwitchIdA, 10, "uplinks", members...)
+	if err != nil {
+		t.Fatalf("Cannot configure port channel: %s", err)
+	}
+	if pc.Dn != "fabric/lan/A/pc-10" || pc.OperationalState != "up" {
+		t.Fatalf("Got port channel %+v", pc)
+	}
+
+	if err := client.RemovePortChannelMembers(ctx, pc.Dn, members[1]); err != nil {
+		t.Fatalf("Cannot remove port channel members: %s", err)
+	}
+
+	if err := client.DeletePortChannel(ctx, pc.Dn); err != nil {
+		t.Fatalf("Cannot delete port channel: %s", err)
+	}
+
+	expect := []string{
+		`<configConfMo cookie="" dn="fabric/server/sw-A/slot-1-port-1" inHierarchical="false"><inConfig>` +
+			`<fabricDceSwSrvEp dn="fabric/server/sw-A/slot-1-port-1" portId="1" slotId="1" status="created"/>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/lan/A/phys-slot-1-port-1" inHierarchical="false"><inConfig>` +
+			`<fabricEthLanEp dn="fabric/lan/A/phys-slot-1-port-1" portId="1" slotId="1" status="created"/>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/san/A/phys-slot-1-port-1" inHierarchical="false"><inConfig>` +
+			`<fabricFcSanEp dn="fabric/san/A/phys-slot-1-port-1" portId="1" slotId="1" status="created"/>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/eth-estc/A/phys-slot-1-port-1" inHierarchical="false"><inConfig>` +
+			`<fabricEthEstcEp dn="fabric/eth-estc/A/phys-slot-1-port-1" portId="1" slotId="1" status="created"/>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/server/sw-B/slot-1-port-5" inHierarchical="false"><inConfig>` +
+			`<fabricDceSwSrvEp dn="fabric/server/sw-B/slot-1-port-5" portId="5" slotId="1" status="deleted"/>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/lan/A/pc-10" inHierarchical="false"><inConfig>` +
+			`<fabricEthLanPc dn="fabric/lan/A/pc-10" name="uplinks" portId="10" status="created,modified">` +
+			`<fabricEthLanPcEp portId="17" rn="ep-slot-1-port-17" slotId="1" status="created,modified"/>` +
+			`<fabricEthLanPcEp portId="18" rn="ep-slot-1-port-18" slotId="1" status="created,modified"/>` +
+			`</fabricEthLanPc>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/lan/A/pc-10" inHierarchical="false"><inConfig>` +
+			`<fabricEthLanPc dn="fabric/lan/A/pc-10" status="modified">` +
+			`<fabricEthLanPcEp portId="18" rn="ep-slot-1-port-18" slotId="1" status="deleted"/>` +
+			`</fabricEthLanPc>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/lan/A/pc-10" inHierarchical="false"><inConfig>` +
+			`<fabricEthLanPc dn="fabric/lan/A/pc-10" status="deleted"/>` +
+			`</inConfig></configConfMo>`,
+	}
+
+	if len(confMos) != len(expect) {
+		t.Fatalf("Got %d configConfMo requests, expect %d", len(confMos), len(expect))
+	}
+
+	for i := range expect {
+		if confMos[i] != expect[i] {
+			t.Fatalf("Got request '%s', expect '%s'", confMos[i], expect[i])
+		}
+	}
+}
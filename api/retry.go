@@ -0,0 +1,217 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// Default settings used by the retry policy.
+const (
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = 500 * time.Millisecond
+	defaultMaxBackoff     = 30 * time.Second
+)
+
+// idempotentMethods contains the XML API methods, which can be safely
+// sent again after a transport error, since they do not change the
+// configuration of the remote endpoint.
+var idempotentMethods = map[string]bool{
+	"aaaLogin":               true,
+	"aaaRefresh":             true,
+	"aaaKeepAlive":           true,
+	"aaaLogout":              true,
+	"configResolveDn":        true,
+	"configResolveDns":       true,
+	"configResolveClass":     true,
+	"configResolveClasses":   true,
+	"configResolveChildren":  true,
+	"configResolveParent":    true,
+	"configScope":            true,
+	"configFindDnsByClassId": true,
+	"eventSubscribe":         true,
+	"eventUnsubscribe":       true,
+}
+
+// RetryPolicy configures how a Client retries requests, which failed because
+// of a transient error. Retries are delayed using an exponential backoff with
+// jitter and each attempt waits for a token from the RateLimit token bucket,
+// if one is configured.
+//
+// Requests which may change the configuration of the remote endpoint,
+// e.g. configConfMo, are not idempotent. Unless RetryNonIdempotent is set such
+// requests are only retried when the remote endpoint has explicitly rejected
+// them with one of the retryable error codes, as in that case the request was
+// not applied. Transport errors and HTTP status codes give no such guarantee.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a request,
+	// including the first one. If zero, a request is attempted up to 3 times.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry, which is doubled on
+	// each subsequent retry. If zero, an initial backoff of 500ms is used.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between two attempts.
+	// If zero, a maximum backoff of 30 seconds is used.
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction of the delay, between 0 and 1, which is randomized
+	// in order to spread retries of concurrent requests. Values outside of
+	// this range are clamped.
+	Jitter float64
+
+	// ErrorCodes are the UCS error codes, which are retryable.
+	// If nil, requests rejected with ErrorCodeThrottled are retried.
+	ErrorCodes []string
+
+	// StatusCodes are the HTTP status codes, which are retryable.
+	// If nil, the 502, 503 and 504 status codes are retried.
+	StatusCodes []int
+
+	// RetryNonIdempotent enables retrying of requests, which are not
+	// idempotent, on transport errors and retryable HTTP status codes.
+	RetryNonIdempotent bool
+}
+
+// newRetryPolicy creates a copy of the given retry policy with defaults applied.
+func newRetryPolicy(policy RetryPolicy) *RetryPolicy {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaultMaxAttempts
+	}
+
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = defaultInitialBackoff
+	}
+
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = defaultMaxBackoff
+	}
+
+	if policy.Jitter < 0 {
+		policy.Jitter = 0
+	} else if policy.Jitter > 1 {
+		policy.Jitter = 1
+	}
+
+	if policy.ErrorCodes == nil {
+		policy.ErrorCodes = []string{ErrorCodeThrottled}
+	}
+
+	if policy.StatusCodes == nil {
+		policy.StatusCodes = []int{
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		}
+	}
+
+	return &policy
+}
+
+// retryable returns a boolean indicating whether a request which failed with
+// the given error can be retried.
+func (p *RetryPolicy) retryable(err error, idempotent bool) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		for _, code := range p.ErrorCodes {
+			if apiErr.Code == code {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if !idempotent && !p.RetryNonIdempotent {
+		return false
+	}
+
+	var transportErr *TransportError
+	if errors.As(err, &transportErr) {
+		for _, code := range p.StatusCodes {
+			if transportErr.StatusCode == code {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return transientError(err)
+}
+
+// transientError returns a boolean indicating whether the given transport error
+// is transient, i.e. a timeout, a refused or reset connection or a connection
+// closed before the response was received. Permanent errors, e.g. failed TLS
+// certificate verification or an unsupported URL scheme, are not transient.
+func transientError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// backoff returns the delay before the given retry, starting from one.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		delay -= time.Duration(p.Jitter * rand.Float64() * float64(delay))
+	}
+
+	return delay
+}
+
+// retry calls the given function, which sends a request, until it succeeds or
+// fails with an error, which is not retryable according to the retry policy.
+func (c *Client) retry(ctx context.Context, in, out interface{}, attempt func() error) error {
+	if c.retryPolicy == nil {
+		return attempt()
+	}
+
+	idempotent := idempotentMethods[methodName(in)]
+	for n := 1; ; n++ {
+		err := attempt()
+		if err == nil || n >= c.retryPolicy.MaxAttempts || !c.retryPolicy.retryable(err, idempotent) {
+			return err
+		}
+
+		// Responses which were already processed partially cannot be retried.
+		if p, ok := out.(partialResponse); ok && p.partial() {
+			return err
+		}
+
+		timer := time.NewTimer(c.retryPolicy.backoff(n))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		// Discard any partially decoded response before the next attempt.
+		resetValue(out)
+	}
+}
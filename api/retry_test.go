@@ -0,0 +1,197 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newFlakyServer creates a Cisco UCS API endpoint, which fails the given number
+// of requests using the given failure function before responding successfully.
+func newFlakyServer(failures int32, fail func(w http.ResponseWriter)) (*httptest.Server, *int32) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= failures {
+			fail(w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, `<configResolveDn dn="sys" response="yes"><outConfig><topSystem dn="sys" name="ucs01"/></outConfig></configResolveDn>`)
+	}))
+
+	return ts, &calls
+}
+
+func unavailable(w http.ResponseWriter) {
+	http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+}
+
+func disconnect(w http.ResponseWriter) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		panic(err)
+	}
+	conn.Close()
+}
+
+func throttled(w http.ResponseWriter) {
+	fmt.Fprint(w, `<configResolveDn dn="sys" response="yes" errorCode="572" invocationResult="unidentified-fail" errorDescr="Too many requests"/>`)
+}
+
+// testRetryPolicy retries requests without waiting between attempts.
+var testRetryPolicy = &RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     time.Millisecond,
+}
+
+// configConfMoRequest is used for sending a non-idempotent request.
+type configConfMoRequest struct {
+	XMLName xml.Name `xml:"configConfMo"`
+	Dn      string   `xml:"dn,attr"`
+}
+
+func TestRetry(t *testing.T) {
+	var tests = []struct {
+		name     string
+		failures int32
+		fail     func(w http.ResponseWriter)
+		in       interface{}
+		calls    int32
+		ok       bool
+	}{
+		{name: "status", failures: 2, fail: unavailable, in: &ConfigResolveDnRequest{Dn: "sys"}, calls: 3, ok: true},
+		{name: "disconnect", failures: 2, fail: disconnect, in: &ConfigResolveDnRequest{Dn: "sys"}, calls: 3, ok: true},
+		{name: "error code", failures: 2, fail: throttled, in: &ConfigResolveDnRequest{Dn: "sys"}, calls: 3, ok: true},
+		{name: "max attempts", failures: 3, fail: unavailable, in: &ConfigResolveDnRequest{Dn: "sys"}, calls: 3, ok: false},
+		{name: "non-idempotent status", failures: 1, fail: unavailable, in: &configConfMoRequest{Dn: "sys"}, calls: 1, ok: false},
+		{name: "non-idempotent error code", failures: 1, fail: throttled, in: &configConfMoRequest{Dn: "sys"}, calls: 2, ok: true},
+	}
+
+	for _, test := range tests {
+		ts, calls := newFlakyServer(test.failures, test.fail)
+
+		client, err := NewClient(Config{Endpoint: ts.URL + "/", Retry: testRetryPolicy})
+		if err != nil {
+			t.Fatalf("%s: cannot create client: %s", test.name, err)
+		}
+
+		var resp ConfigResolveDnResponse
+		err = client.Request(context.Background(), test.in, &resp)
+		ts.Close()
+
+		if ok := err == nil; ok != test.ok {
+			t.Fatalf("%s: got error %v", test.name, err)
+		}
+
+		if got := atomic.LoadInt32(calls); got != test.calls {
+			t.Fatalf("%s: got %d attempts, expect %d", test.name, got, test.calls)
+		}
+
+		if test.ok && resp.IsError() {
+			t.Fatalf("%s: got error response %+v after retry", test.name, resp.BaseResponse)
+		}
+	}
+}
+
+// countingTransport counts the requests sent using the default transport.
+type countingTransport struct {
+	calls int32
+}
+
+func (ct *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&ct.calls, 1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRetryTransportErrors(t *testing.T) {
+	// The TLS handshake fails, since the certificate of the server is not trusted.
+	tlsServer := httptest.NewUnstartedServer(http.NotFoundHandler())
+	tlsServer.Config.ErrorLog = log.New(io.Discard, "", 0)
+	tlsServer.StartTLS()
+	defer tlsServer.Close()
+
+	closedServer := httptest.NewServer(http.NotFoundHandler())
+	closedServer.Close()
+
+	var tests = []struct {
+		name     string
+		endpoint string
+		calls    int32
+	}{
+		{name: "untrusted certificate", endpoint: tlsServer.URL + "/", calls: 1},
+		{name: "unsupported scheme", endpoint: "ftp://ucs01.example.org/", calls: 1},
+		{name: "connection refused", endpoint: closedServer.URL + "/", calls: 3},
+	}
+
+	for _, test := range tests {
+		transport := &countingTransport{}
+		config := Config{
+			Endpoint:   test.endpoint,
+			HttpClient: &http.Client{Transport: transport},
+			Retry:      testRetryPolicy,
+		}
+
+		client, err := NewClient(config)
+		if err != nil {
+			t.Fatalf("%s: cannot create client: %s", test.name, err)
+		}
+
+		var resp ConfigResolveDnResponse
+		if err := client.Request(context.Background(), &ConfigResolveDnRequest{Dn: "sys"}, &resp); err == nil {
+			t.Fatalf("%s: expect error", test.name)
+		}
+
+		if got := atomic.LoadInt32(&transport.calls); got != test.calls {
+			t.Fatalf("%s: got %d attempts, expect %d", test.name, got, test.calls)
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := newRetryPolicy(RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Jitter:         0.5,
+	})
+
+	var tests = []struct {
+		retry int
+		min   time.Duration
+		max   time.Duration
+	}{
+		{retry: 1, min: 50 * time.Millisecond, max: 100 * time.Millisecond},
+		{retry: 2, min: 100 * time.Millisecond, max: 200 * time.Millisecond},
+		{retry: 3, min: 200 * time.Millisecond, max: 400 * time.Millisecond},
+		{retry: 10, min: 500 * time.Millisecond, max: time.Second},
+	}
+
+	for _, test := range tests {
+		got := policy.backoff(test.retry)
+		if got < test.min || got > test.max {
+			t.Fatalf("Got backoff %s for retry %d, expect between %s and %s", got, test.retry, test.min, test.max)
+		}
+	}
+}
+
+func TestRetryBackoffJitterClamped(t *testing.T) {
+	policy := newRetryPolicy(RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Jitter:         5,
+	})
+
+	for retry := 1; retry <= 10; retry++ {
+		if got := policy.backoff(retry); got < 0 {
+			t.Fatalf("Got negative backoff %s for retry %d", got, retry)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// testRequest is a request received by a testServer.
+type testRequest struct {
+	Method string
+	Attrs  map[string]string
+	Body   []byte
+}
+
+// testHandler returns the XML document sent back in response to a request.
+type testHandler func(req testRequest) string
+
+// testServer is a fake Cisco UCS API endpoint, which dispatches requests
+// to handlers based on the name of the requested XML API method.
+type testServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]testHandler
+	calls    map[string]int
+}
+
+// newTestServer creates and starts a new fake Cisco UCS API endpoint.
+func newTestServer(t *testing.T, handlers map[string]testHandler) *testServer {
+	ts := &testServer{
+		handlers: handlers,
+		calls:    make(map[string]int),
+	}
+
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Cannot read request body: %s", err)
+			return
+		}
+
+		var root struct {
+			XMLName xml.Name
+			Attrs   []xml.Attr `xml:",any,attr"`
+		}
+		if err := xml.Unmarshal(body, &root); err != nil {
+			t.Errorf("Cannot unmarshal request %q: %s", body, err)
+			return
+		}
+
+		req := testRequest{
+			Method: root.XMLName.Local,
+			Attrs:  make(map[string]string),
+			Body:   body,
+		}
+		for _, attr := range root.Attrs {
+			req.Attrs[attr.Name.Local] = attr.Value
+		}
+
+		ts.mu.Lock()
+		ts.calls[req.Method]++
+		handler, ok := ts.handlers[req.Method]
+		ts.mu.Unlock()
+
+		if !ok {
+			t.Errorf("Unexpected request for method %s", req.Method)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, handler(req))
+	}))
+
+	return ts
+}
+
+// Calls returns the number of requests received for the given method.
+func (ts *testServer) Calls(method string) int {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	return ts.calls[method]
+}
+
+// NewClient creates a new API client for the fake endpoint.
+func (ts *testServer) NewClient(t *testing.T, config Config) *Client {
+	config.Endpoint = ts.URL + "/"
+	config.Username = "admin"
+	config.Password = "password"
+
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("Cannot create client: %s", err)
+	}
+
+	return client
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// bindingDn returns the DN of the binding of the service profile with the given DN.
+func bindingDn(profileDn string) string {
+	return profileDn + "/pn"
+}
+
+// CreateServiceProfile creates the given service profile, which is identified by
+// its Dn field, including any of its children. The created service profile is returned.
+func (c *Client) CreateServiceProfile(ctx context.Context, profile mo.LsServer) (*mo.LsServer, error) {
+	if profile.Dn == "" {
+		return nil, ErrNoDn
+	}
+
+	if profile.Status == "" {
+		profile.Status = mo.StatusCreated
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             profile.Dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: profile},
+	}
+
+	var out mo.LsServer
+	if err := c.ConfigConfMo(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// AssociateServiceProfile associates the service profile with the given DN to the
+// physical server with the given DN, e.g. a blade. The association is performed
+// asynchronously by the remote endpoint, use WaitForAssociation to wait for it.
+func (c *Client) AssociateServiceProfile(ctx context.Context, profileDn, serverDn string) error {
+	binding := mo.LsBinding{
+		Dn:                bindingDn(profileDn),
+		PnDn:              serverDn,
+		RestrictMigration: "no",
+		Status:            mo.StatusCreatedModified,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             binding.Dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: binding},
+	}
+
+	return c.ConfigConfMo(ctx, req, nil)
+}
+
+// DisassociateServiceProfile disassociates the service profile with the given DN
+// from the physical server it is associated to.
+func (c *Client) DisassociateServiceProfile(ctx context.Context, profileDn string) error {
+	binding := mo.LsBinding{
+		Dn:     bindingDn(profileDn),
+		Status: mo.StatusDeleted,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             binding.Dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: binding},
+	}
+
+	return c.ConfigConfMo(ctx, req, nil)
+}
+
+// WaitForAssociation waits for the association of the service profile with the given
+// DN to complete by polling the FSM of the physical server it is bound to, which
+// is reported through the given function, if not nil. See WaitForFsm for details.
+//
+// The result of a previous run of the FSM, e.g. an earlier failed association,
+// is ignored. The given baseline is the state of the FSM of the physical server,
+// which should be retrieved using FsmState before AssociateServiceProfile. If it
+// is nil, the state of the FSM when polling starts is used instead. If the service
+// profile is already associated to the physical server and no FSM is running, e.g.
+// because the association did not change, WaitForAssociation returns immediately.
+//
+// The associated service profile is returned. If the association has failed
+// the returned error is an *FsmError.
+func (c *Client) WaitForAssociation(ctx context.Context, profileDn string, baseline *mo.FiniteStateMachineTask, interval time.Duration, progress FsmProgressFunc) (*mo.LsServer, error) {
+	var binding mo.LsBinding
+	if err := c.resolveDn(ctx, bindingDn(profileDn), &binding); err != nil {
+		return nil, err
+	}
+
+	associated := false
+	if binding.PnDn != "" && binding.AssignedToDn == binding.PnDn {
+		var server fsmObject
+		if err := c.resolveDn(ctx, binding.PnDn, &server); err != nil {
+			return nil, err
+		}
+
+		associated = server.Association == "associated" && !fsmRunning(server.FiniteStateMachineTask)
+	}
+
+	// The service profile is associated once the physical server reports the
+	// association and no longer runs the FSM performing it. This is checked
+	// only once a new run of the FSM has been seen by pollFsm.
+	done := func(obj fsmObject) (bool, error) {
+		ok, err := fsmDone(binding.PnDn, obj.FiniteStateMachineTask)
+		if err != nil {
+			return true, err
+		}
+
+		return ok && obj.Association == "associated", nil
+	}
+
+	if !associated {
+		if _, err := c.pollFsm(ctx, binding.PnDn, baseline, interval, progress, done); err != nil {
+			return nil, err
+		}
+	}
+
+	var profile mo.LsServer
+	if err := c.resolveDn(ctx, profileDn, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func TestServiceProfileAssociation(t *testing.T) {
+	var confMos []string
+	blade := []string{
+		`association="none" fsmStatus="nop" fsmPrev="DisassociateSuccess"`,
+		`association="establishing" fsmStatus="AssociateExecute" fsmProgr="50" fsmStageDescr="Configure adapter"`,
+		`association="associated" fsmStatus="nop" fsmPrev="AssociateSuccess" fsmProgr="100"`,
+	}
+
+	ts := newTestServer(t, map[string]testHandler{
+		"configConfMo": func(req testRequest) string {
+			confMos = append(confMos, string(req.Body))
+			return `<configConfMo dn="` + req.Attrs["dn"] + `" response="yes"><outConfig>` +
+				`<lsServer dn="org-root/ls-web01" name="web01" assocState="unassociated" status="created"/>` +
+				`</outConfig></configConfMo>`
+		},
+		"configResolveDn": func(req testRequest) string {
+			var out string
+			switch dn := req.Attrs["dn"]; dn {
+			case "org-root/ls-web01/pn":
+				out = `<lsBinding dn="org-root/ls-web01/pn" pnDn="sys/chassis-1/blade-1"/>`
+			case "sys/chassis-1/blade-1":
+				out = `<computeBlade dn="sys/chassis-1/blade-1" ` + blade[0] + `/>`
+				if len(blade) > 1 {
+					blade = blade[1:]
+				}
+			case "org-root/ls-web01":
+				out = `<lsServer dn="org-root/ls-web01" name="web01" assocState="associated" pnDn="sys/chassis-1/blade-1"/>`
+			}
+
+			return `<configResolveDn dn="` + req.Attrs["dn"] + `" response="yes"><outConfig>` + out + `</outConfig></configResolveDn>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	profile := mo.LsServer{
+		Dn:   "org-root/ls-web01",
+		Name: "web01",
+		Type: mo.LsServerTypeInstance,
+		Power: &mo.LsPower{
+			State: "up",
+		},
+	}
+
+	created, err := client.CreateServiceProfile(ctx, profile)
+	if err != nil {
+		t.Fatalf("Cannot create service profile: %s", err)
+	}
+
+	if created.Name != "web01" || created.AssocState != "unassociated" {
+		t.Fatalf("Got service profile %+v", created)
+	}
+
+	if err := client.AssociateServiceProfile(ctx, "org-root/ls-web01", "sys/chassis-1/blade-1"); err != nil {
+		t.Fatalf("Cannot associate service profile: %s", err)
+	}
+
+	var progress []int
+	associated, err := client.WaitForAssociation(ctx, "org-root/ls-web01", nil, time.Millisecond, func(task mo.FiniteStateMachineTask) {
+		progress = append(progress, task.FsmProgress)
+	})
+	if err != nil {
+		t.Fatalf("Cannot wait for association: %s", err)
+	}
+
+	if associated.AssocState != "associated" || associated.PnDn != "sys/chassis-1/blade-1" {
+		t.Fatalf("Got service profile %+v", associated)
+	}
+
+	if len(progress) != 3 || progress[1] != 50 || progress[2] != 100 {
+		t.Fatalf("Got progress updates %v", progress)
+	}
+
+	if err := client.DisassociateServiceProfile(ctx, "org-root/ls-web01"); err != nil {
+		t.Fatalf("Cannot disassociate service profile: %s", err)
+	}
+
+	expect := []string{
+		`<configConfMo cookie="" dn="org-root/ls-web01" inHierarchical="false"><inConfig>` +
+			`<lsServer dn="org-root/ls-web01" name="web01" status="created" type="instance"><lsPower state="up"/></lsServer>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="org-root/ls-web01/pn" inHierarchical="false"><inConfig>` +
+			`<lsBinding dn="org-root/ls-web01/pn" pnDn="sys/chassis-1/blade-1" restrictMigration="no" status="created,modified"/>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="org-root/ls-web01/pn" inHierarchical="false"><inConfig>` +
+			`<lsBinding dn="org-root/ls-web01/pn" status="deleted"/>` +
+			`</inConfig></configConfMo>`,
+	}
+
+	if len(confMos) != len(expect) {
+		t.Fatalf("Got %d configConfMo requests, expect %d", len(confMos), len(expect))
+	}
+
+	for i := range expect {
+		if confMos[i] != expect[i] {
+			t.Fatalf("Got request '%s', expect '%s'", confMos[i], expect[i])
+		}
+	}
+
+	if _, err := client.CreateServiceProfile(ctx, mo.LsServer{Name: "web02"}); err != ErrNoDn {
+		t.Fatalf("Got error %v, expect %v", err, ErrNoDn)
+	}
+}
+
+func TestWaitForAssociationAfterFailure(t *testing.T) {
+	// The blade still reports the failure of an earlier association,
+	// before the FSM of the new association starts.
+	blade := []string{
+		`association="none" fsmStatus="nop" fsmPrev="AssociateFail" fsmStamp="2026-10-17T10:00:00.000" fsmRmtInvErrCode="ERR-2fa-timeout"`,
+		`association="none" fsmStatus="nop" fsmPrev="AssociateFail" fsmStamp="2026-10-17T10:00:00.000" fsmRmtInvErrCode="ERR-2fa-timeout"`,
+		`association="establishing" fsmStatus="AssociateExecute" fsmProgr="50" fsmStamp="2026-10-17T11:00:00.000"`,
+		`association="associated" fsmStatus="nop" fsmPrev="AssociateSuccess" fsmProgr="100" fsmStamp="2026-10-17T11:05:00.000"`,
+	}
+
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveDn": func(req testRequest) string {
+			var out string
+			switch dn := req.Attrs["dn"]; dn {
+			case "org-root/ls-web01/pn":
+				out = `<lsBinding dn="org-root/ls-web01/pn" pnDn="sys/chassis-1/blade-1"/>`
+			case "sys/chassis-1/blade-1":
+				out = `<computeBlade dn="sys/chassis-1/blade-1" ` + blade[0] + `/>`
+				if len(blade) > 1 {
+					blade = blade[1:]
+				}
+			case "org-root/ls-web01":
+				out = `<lsServer dn="org-root/ls-web01" name="web01" assocState="associated" pnDn="sys/chassis-1/blade-1"/>`
+			}
+
+			return `<configResolveDn dn="` + req.Attrs["dn"] + `" response="yes"><outConfig>` + out + `</outConfig></configResolveDn>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	associated, err := client.WaitForAssociation(context.Background(), "org-root/ls-web01", nil, time.Millisecond, nil)
+	if err != nil {
+		t.Fatalf("Cannot wait for association: %s", err)
+	}
+
+	if associated.AssocState != "associated" {
+		t.Fatalf("Got service profile %+v", associated)
+	}
+
+	if len(blade) != 1 {
+		t.Fatalf("Got %d blade states left, expect 1", len(blade))
+	}
+}
+
+func TestWaitForAssociationUnchanged(t *testing.T) {
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveDn": func(req testRequest) string {
+			var out string
+			switch dn := req.Attrs["dn"]; dn {
+			case "org-root/ls-web01/pn":
+				out = `<lsBinding dn="org-root/ls-web01/pn" pnDn="sys/chassis-1/blade-1" assignedToDn="sys/chassis-1/blade-1"/>`
+			case "sys/chassis-1/blade-1":
+				out = `<computeBlade dn="sys/chassis-1/blade-1" association="associated" fsmStatus="nop" fsmPrev="AssociateSuccess" fsmStamp="2026-10-17T10:00:00.000"/>`
+			case "org-root/ls-web01":
+				out = `<lsServer dn="org-root/ls-web01" name="web01" assocState="associated" pnDn="sys/chassis-1/blade-1"/>`
+			}
+
+			return `<configResolveDn dn="` + req.Attrs["dn"] + `" response="yes"><outConfig>` + out + `</outConfig></configResolveDn>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	// Associating the service profile to the blade it is already associated
+	// to does not start the FSM, so waiting for it must not block.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	associated, err := client.WaitForAssociation(ctx, "org-root/ls-web01", nil, time.Millisecond, nil)
+	if err != nil {
+		t.Fatalf("Cannot wait for association: %s", err)
+	}
+
+	if associated.AssocState != "associated" {
+		t.Fatalf("Got service profile %+v", associated)
+	}
+
+	if n := ts.Calls("configResolveDn"); n != 3 {
+		t.Fatalf("Got %d configResolveDn requests, expect 3", n)
+	}
+}
+
+func TestServiceProfileVnics(t *testing.T) {
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveDn": func(req testRequest) string {
+			return `<configResolveDn dn="org-root/ls-web01" response="yes"><outConfig>` +
+				`<lsServer dn="org-root/ls-web01" name="web01">` +
+				`<vnicEther rn="ether-eth0" name="eth0" addr="00:25:B5:00:00:1F" switchId="A" nwTemplName="eth-a">` +
+				`<vnicEtherIf rn="if-default" name="default" vnet="1" defaultNet="yes"/>` +
+				`<vnicEtherIf rn="if-web" name="web" vnet="100" defaultNet="no"/>` +
+				`</vnicEther>` +
+				`<vnicFc rn="fc-fc0" name="fc0" addr="20:00:00:25:B5:00:00:1F" switchId="A">` +
+				`<vnicFcIf rn="if-default" name="vsan-a" vnet="10"/>` +
+				`</vnicFc>` +
+				`<lsPower rn="power" state="up"/>` +
+				`</lsServer>` +
+				`</outConfig></configResolveDn>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	var profile mo.LsServer
+	req := ConfigResolveDnRequest{Dn: "org-root/ls-web01", InHierarchical: "true"}
+	if err := client.ConfigResolveDn(context.Background(), req, &profile); err != nil {
+		t.Fatalf("Cannot resolve service profile: %s", err)
+	}
+
+	if len(profile.VnicEthers) != 1 || len(profile.VnicEthers[0].Interfaces) != 2 {
+		t.Fatalf("Got vNICs %+v", profile.VnicEthers)
+	}
+
+	vnic := profile.VnicEthers[0]
+	if vnic.Addr != "00:25:B5:00:00:1F" || vnic.Interfaces[1].Name != "web" || vnic.Interfaces[1].Vnet != 100 {
+		t.Fatalf("Got vNIC %+v", vnic)
+	}
+
+	if len(profile.VnicFcs) != 1 || len(profile.VnicFcs[0].Interfaces) != 1 || profile.VnicFcs[0].Interfaces[0].Vnet != 10 {
+		t.Fatalf("Got vHBAs %+v", profile.VnicFcs)
+	}
+
+	if profile.Power == nil || profile.Power.State != "up" {
+		t.Fatalf("Got power %+v", profile.Power)
+	}
+}
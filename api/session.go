@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Default settings used by the session manager.
+const (
+	defaultRefreshMargin  = 1 * time.Minute
+	defaultRefreshTimeout = 30 * time.Second
+)
+
+// Session configures the session manager of a Client.
+//
+// When the session manager is enabled the Client logs in lazily on the first
+// request which needs an authentication cookie, refreshes the session ahead
+// of its expiry using the refresh period returned by the remote API endpoint
+// and transparently logs in again when a request fails because the session
+// has expired.
+type Session struct {
+	// RefreshMargin defines how long before the end of the refresh period
+	// the session is refreshed. If zero, a margin of one minute is used.
+	RefreshMargin time.Duration
+
+	// RefreshTimeout defines the maximum time a background refresh of the
+	// session may take. If zero, a timeout of 30 seconds is used.
+	RefreshTimeout time.Duration
+}
+
+// cookieRequest is implemented by requests which carry an authentication cookie.
+type cookieRequest interface {
+	setCookie(cookie string)
+}
+
+// sessionManager keeps the authentication cookie of a Client alive.
+type sessionManager struct {
+	config Session
+
+	// loginMu serializes logins and refreshes of the session.
+	loginMu sync.Mutex
+
+	// mu guards the refresh timer and whether the session manager is stopped.
+	mu      sync.Mutex
+	timer   *time.Timer
+	stopped bool
+}
+
+// newSessionManager creates a new session manager from the given config.
+func newSessionManager(config Session) *sessionManager {
+	if config.RefreshMargin <= 0 {
+		config.RefreshMargin = defaultRefreshMargin
+	}
+
+	if config.RefreshTimeout <= 0 {
+		config.RefreshTimeout = defaultRefreshTimeout
+	}
+
+	s := &sessionManager{
+		config: config,
+	}
+
+	return s
+}
+
+// update schedules a refresh of the session identified by the given cookie
+// ahead of the given refresh period in seconds. An empty cookie cancels any
+// pending refresh.
+func (s *sessionManager) update(c *Client, cookie string, refreshPeriod int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+
+	if s.stopped || cookie == "" || refreshPeriod <= 0 {
+		return
+	}
+
+	period := time.Duration(refreshPeriod) * time.Second
+	delay := period - s.config.RefreshMargin
+	if delay <= 0 {
+		delay = period / 2
+	}
+
+	s.timer = time.AfterFunc(delay, func() {
+		s.refresh(c)
+	})
+}
+
+// stop cancels any pending refresh and prevents further refreshes from being scheduled.
+func (s *sessionManager) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+
+	s.stopped = true
+}
+
+// refresh refreshes the session in the background. If the session cannot be
+// refreshed the cookie is dropped, so that the next request logs in again.
+func (s *sessionManager) refresh(c *Client) {
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+
+	if c.Cookie() == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.RefreshTimeout)
+	defer cancel()
+
+	// The refresh must not be delayed by the rate limiter past the expiry of the session.
+	if _, err := c.aaaRefresh(ctx, c.RequestNow); err != nil {
+		c.setCookie("", 0)
+	}
+}
+
+// login returns a valid authentication cookie, logging in if there is no
+// session yet or if the current cookie is the given expired one.
+func (s *sessionManager) login(ctx context.Context, c *Client, expired string) (string, error) {
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+
+	// Another request may have already logged in while we were waiting.
+	if cookie := c.Cookie(); cookie != "" && cookie != expired {
+		return cookie, nil
+	}
+
+	if _, err := c.AaaLogin(ctx); err != nil {
+		return "", err
+	}
+
+	return c.Cookie(), nil
+}
+
+// do sends a request using the cookie of the current session. If the remote API
+// endpoint reports that the session has expired we login again and retry once.
+func (s *sessionManager) do(ctx context.Context, c *Client, in cookieRequest, out interface{}) error {
+	cookie := c.Cookie()
+	if cookie == "" {
+		var err error
+		if cookie, err = s.login(ctx, c, ""); err != nil {
+			return err
+		}
+	}
+
+	in.setCookie(cookie)
+	if err := c.doRequest(ctx, in, out); !IsSessionExpired(err) {
+		return err
+	}
+
+	cookie, err := s.login(ctx, c, cookie)
+	if err != nil {
+		return err
+	}
+
+	// Discard the previous error response before decoding the new one.
+	resetValue(out)
+
+	in.setCookie(cookie)
+
+	return c.doRequest(ctx, in, out)
+}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSessions emulates the session handling of a Cisco UCS API endpoint.
+// Cookies remain valid until the sessions are expired.
+type fakeSessions struct {
+	mu            sync.Mutex
+	count         int
+	valid         map[string]bool
+	refreshPeriod int
+}
+
+func (f *fakeSessions) newCookie() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.valid == nil {
+		f.valid = make(map[string]bool)
+	}
+
+	f.count++
+	cookie := fmt.Sprintf("cookie-%d", f.count)
+	f.valid[cookie] = true
+
+	return cookie
+}
+
+func (f *fakeSessions) isValid(cookie string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.valid[cookie]
+}
+
+func (f *fakeSessions) expire() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.valid = nil
+}
+
+func (f *fakeSessions) handlers() map[string]testHandler {
+	login := func(method string) testHandler {
+		return func(req testRequest) string {
+			return fmt.Sprintf(`<%s response="yes" outCookie="%s" outRefreshPeriod="%d"/>`, method, f.newCookie(), f.refreshPeriod)
+		}
+	}
+
+	handlers := map[string]testHandler{
+		"aaaLogin":   login("aaaLogin"),
+		"aaaRefresh": login("aaaRefresh"),
+		"aaaLogout": func(req testRequest) string {
+			f.expire()
+			return `<aaaLogout response="yes" outStatus="success"/>`
+		},
+		"configResolveDn": func(req testRequest) string {
+			if !f.isValid(req.Attrs["cookie"]) {
+				return `<configResolveDn dn="sys" response="yes" errorCode="552" invocationResult="unidentified-fail" errorDescr="Authorization required"/>`
+			}
+			return `<configResolveDn dn="sys" response="yes"><outConfig><topSystem dn="sys" name="ucs01"/></outConfig></configResolveDn>`
+		},
+		"configResolveClass": func(req testRequest) string {
+			if !f.isValid(req.Attrs["cookie"]) {
+				return `<configResolveClass response="yes" errorCode="552" invocationResult="unidentified-fail" errorDescr="Authorization required"/>`
+			}
+			return `<configResolveClass response="yes"><outConfigs><computeBlade dn="sys/chassis-1/blade-1"/><computeBlade dn="sys/chassis-1/blade-2"/></outConfigs></configResolveClass>`
+		},
+	}
+
+	return handlers
+}
+
+func TestSessionLazyLoginAndRelogin(t *testing.T) {
+	sessions := &fakeSessions{refreshPeriod: 600}
+	ts := newTestServer(t, sessions.handlers())
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{Session: &Session{}})
+	ctx := context.Background()
+
+	var sys struct {
+		Name string `xml:"name,attr"`
+	}
+
+	req := ConfigResolveDnRequest{Dn: "sys"}
+	if err := client.ConfigResolveDn(ctx, req, &sys); err != nil {
+		t.Fatalf("Cannot resolve DN: %s", err)
+	}
+
+	if sys.Name != "ucs01" {
+		t.Fatalf("Got name %q, expect %q", sys.Name, "ucs01")
+	}
+
+	if got := ts.Calls("aaaLogin"); got != 1 {
+		t.Fatalf("Got %d logins, expect 1", got)
+	}
+
+	// Expire the session on the remote endpoint, which should make
+	// the client login again and retry the request.
+	sessions.expire()
+	if err := client.ConfigResolveDn(ctx, req, &sys); err != nil {
+		t.Fatalf("Cannot resolve DN after session expired: %s", err)
+	}
+
+	if got := ts.Calls("aaaLogin"); got != 2 {
+		t.Fatalf("Got %d logins, expect 2", got)
+	}
+
+	if got := ts.Calls("configResolveDn"); got != 3 {
+		t.Fatalf("Got %d requests, expect 3", got)
+	}
+
+	if _, err := client.AaaLogout(ctx); err != nil {
+		t.Fatalf("Cannot logout: %s", err)
+	}
+}
+
+func TestSessionRefreshBeforeExpiry(t *testing.T) {
+	sessions := &fakeSessions{refreshPeriod: 1}
+	ts := newTestServer(t, sessions.handlers())
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{Session: &Session{RefreshMargin: 500 * time.Millisecond}})
+	ctx := context.Background()
+
+	if _, err := client.AaaLogin(ctx); err != nil {
+		t.Fatalf("Cannot login: %s", err)
+	}
+	defer client.AaaLogout(ctx)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for ts.Calls("aaaRefresh") < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Session was not refreshed, got %d refreshes", ts.Calls("aaaRefresh"))
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestSessionStopRefresh(t *testing.T) {
+	sessions := &fakeSessions{refreshPeriod: 1}
+	ts := newTestServer(t, sessions.handlers())
+	defer ts.Close()
+
+	ctx := context.Background()
+	config := Config{Session: &Session{RefreshMargin: 900 * time.Millisecond}}
+
+	// Neither a closed client nor a client which has logged out
+	// may refresh its session in the background.
+	closed := ts.NewClient(t, config)
+	if _, err := closed.AaaLogin(ctx); err != nil {
+		t.Fatalf("Cannot login: %s", err)
+	}
+	closed.Close()
+
+	loggedOut := ts.NewClient(t, config)
+	if _, err := loggedOut.AaaLogin(ctx); err != nil {
+		t.Fatalf("Cannot login: %s", err)
+	}
+	if _, err := loggedOut.AaaLogout(ctx); err != nil {
+		t.Fatalf("Cannot logout: %s", err)
+	}
+
+	time.Sleep(500 * time.Millisecond)
+
+	if got := ts.Calls("aaaRefresh"); got != 0 {
+		t.Fatalf("Got %d refreshes, expect 0", got)
+	}
+
+	if cookie := loggedOut.Cookie(); cookie != "" {
+		t.Fatalf("Got cookie %q after logout, expect none", cookie)
+	}
+}
+
+func TestSessionRefreshAfterFailedLogout(t *testing.T) {
+	sessions := &fakeSessions{refreshPeriod: 1}
+	handlers := sessions.handlers()
+	handlers["aaaLogout"] = func(req testRequest) string {
+		return `<aaaLogout response="yes" errorCode="553" invocationResult="unidentified-fail" errorDescr="Authorization denied"/>`
+	}
+
+	ts := newTestServer(t, handlers)
+	defer ts.Close()
+
+	// The session is still valid after a failed logout,
+	// so it has to be refreshed in the background.
+	client := ts.NewClient(t, Config{Session: &Session{RefreshMargin: 900 * time.Millisecond}})
+	defer client.Close()
+
+	ctx := context.Background()
+	if _, err := client.AaaLogin(ctx); err != nil {
+		t.Fatalf("Cannot login: %s", err)
+	}
+
+	if _, err := client.AaaLogout(ctx); err == nil {
+		t.Fatalf("Expect logout to fail")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for ts.Calls("aaaRefresh") < 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Session was not refreshed after failed logout")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestSessionRefreshBypassesRateLimit(t *testing.T) {
+	sessions := &fakeSessions{refreshPeriod: 1}
+	ts := newTestServer(t, sessions.handlers())
+	defer ts.Close()
+
+	// The login consumes the only token, so a rate limited
+	// refresh would fail waiting for the next one.
+	config := Config{
+		RateLimit: &RateLimit{RequestsPerSecond: 0.001, Burst: 1, Wait: 10 * time.Millisecond},
+		Session:   &Session{RefreshMargin: 900 * time.Millisecond},
+	}
+	client := ts.NewClient(t, config)
+	defer client.Close()
+
+	if _, err := client.AaaLogin(context.Background()); err != nil {
+		t.Fatalf("Cannot login: %s", err)
+	}
+	cookie := client.Cookie()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for client.Cookie() == cookie {
+		if time.Now().After(deadline) {
+			t.Fatalf("Session was not refreshed")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	if client.Cookie() == "" {
+		t.Fatalf("Session was dropped instead of refreshed")
+	}
+}
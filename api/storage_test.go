@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func TestBladeStorageInventory(t *testing.T) {
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveDn": func(req testRequest) string {
+			return `<configResolveDn dn="sys/chassis-1/blade-1" response="yes"><outConfig>` +
+				`<computeBlade dn="sys/chassis-1/blade-1" chassisId="1" slotId="1">` +
+				`<computeBoard rn="board" id="1">` +
+				`<storageController rn="storage-SAS-1" id="1" model="LSI MegaRAID SAS 9240">` +
+				`<storageLocalDisk rn="disk-1" id="1" model="ST9300653SS" size="285148" serial="S0M1ABCD" diskState="good" operability="operable" predictiveFailureCount="0"/>` +
+				`<storageLocalDisk rn="disk-2" id="2" model="ST9300653SS" size="285148" serial="S0M1EFGH" diskState="predictive-failure" operability="degraded" predictiveFailureCount="3"/>` +
+				`<storageLocalDisk rn="disk-3" id="3" size="unknown" blockSize="unknown" numberOfBlocks="unknown" physicalBlockSize="unknown" diskState="unknown" presence="missing"/>` +
+				`<storageVirtualDrive rn="vd-0" id="0" name="raid1" type="mirror" driveState="degraded" size="285148" availableSize="not-applicable"/>` +
+				`<storageLocalLun rn="lun-0" id="0" name="boot" size="285148"/>` +
+				`<storageRaidBattery rn="raid-battery" id="1" batteryType="supercap" operability="operable"/>` +
+				`</storageController>` +
+				`<storageFlexFlashController rn="storage-flexflash-1" id="1" controllerHealth="ok" numberOfFlexFlashCards="2"/>` +
+				`</computeBoard>` +
+				`</computeBlade>` +
+				`</outConfig></configResolveDn>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	var blade mo.ComputeBlade
+	req := ConfigResolveDnRequest{Dn: "sys/chassis-1/blade-1", InHierarchical: "true"}
+	if err := client.ConfigResolveDn(context.Background(), req, &blade); err != nil {
+		t.Fatalf("Cannot resolve blade: %s", err)
+	}
+
+	controller := blade.ComputeBoard.StorageController
+	if len(controller.LocalDisks) != 3 {
+		t.Fatalf("Got local disks %+v", controller.LocalDisks)
+	}
+
+	disk := controller.LocalDisks[1]
+	if disk.Serial != "S0M1EFGH" || disk.Size != "285148" || disk.DiskState != "predictive-failure" || disk.PredictiveFailureCount != 3 {
+		t.Fatalf("Got local disk %+v", disk)
+	}
+
+	// The size of a missing disk is reported as unknown.
+	if missing := controller.LocalDisks[2]; missing.Size != "unknown" || missing.NumberOfBlocks != "unknown" || missing.Presence != "missing" {
+		t.Fatalf("Got local disk %+v", missing)
+	}
+
+	if len(controller.VirtualDrives) != 1 || controller.VirtualDrives[0].DriveState != "degraded" || controller.VirtualDrives[0].AvailableSize != "not-applicable" {
+		t.Fatalf("Got virtual drives %+v", controller.VirtualDrives)
+	}
+
+	if len(controller.LocalLuns) != 1 || controller.RaidBattery == nil || controller.RaidBattery.BatteryType != "supercap" {
+		t.Fatalf("Got storage controller %+v", controller)
+	}
+
+	flexFlash := blade.ComputeBoard.FlexFlashControllers
+	if len(flexFlash) != 1 || flexFlash[0].NumberOfFlexFlashCards != 2 {
+		t.Fatalf("Got FlexFlash controllers %+v", flexFlash)
+	}
+}
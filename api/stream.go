@@ -0,0 +1,236 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// ErrAlreadyDecoded is returned when decoding an Object more than once.
+var ErrAlreadyDecoded = errors.New("managed object already decoded")
+
+// Object is a single managed object yielded by the streaming query methods.
+// The managed object is read from the response body only when decoded,
+// so it must be decoded before the ObjectFunc it was passed to returns.
+type Object struct {
+	// Name is the name of the XML element, which is the class of the managed object.
+	Name xml.Name
+
+	decoder *xml.Decoder
+	start   xml.StartElement
+	decoded bool
+}
+
+// Attr returns the value of the given attribute of the managed object,
+// e.g. its dn, without decoding it.
+func (o *Object) Attr(name string) string {
+	for _, attr := range o.start.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+// Decode unmarshals the managed object into the given concrete type.
+func (o *Object) Decode(out mo.Any) error {
+	if o.decoded {
+		return ErrAlreadyDecoded
+	}
+	o.decoded = true
+
+	return o.decoder.DecodeElement(out, &o.start)
+}
+
+// ObjectFunc is called by the streaming query methods for each managed object
+// as it is parsed from the response. Returning an error stops the processing
+// of the response and the error is returned by the query method.
+type ObjectFunc func(obj *Object) error
+
+// bodyDecoder is implemented by responses, which are decoded from the response
+// body while it is being read instead of being unmarshaled as a whole.
+type bodyDecoder interface {
+	decodeBody(d *xml.Decoder) error
+}
+
+// resetter is implemented by responses, which need to be reset in a specific
+// way before they are decoded again.
+type resetter interface {
+	reset()
+}
+
+// partialResponse is implemented by responses, which may have been
+// processed partially before an error occurred.
+type partialResponse interface {
+	partial() bool
+}
+
+// streamResponse is a response, which yields managed objects contained
+// within the outConfigs element to an ObjectFunc as they are parsed.
+type streamResponse struct {
+	BaseResponse
+	OutUnresolved []Dn
+
+	fn      ObjectFunc
+	yielded bool
+}
+
+// reset discards the decoded response, keeping the ObjectFunc in place.
+func (r *streamResponse) reset() {
+	r.BaseResponse = BaseResponse{}
+	r.OutUnresolved = nil
+}
+
+// partial returns a boolean indicating whether any managed objects were yielded.
+func (r *streamResponse) partial() bool {
+	return r.yielded
+}
+
+// decodeBody decodes the response from the given XML token stream.
+func (r *streamResponse) decodeBody(d *xml.Decoder) error {
+	root, err := nextStartElement(d)
+	if err != nil {
+		return err
+	}
+
+	for _, attr := range root.Attr {
+		switch attr.Name.Local {
+		case "cookie":
+			r.Cookie = attr.Value
+		case "response":
+			r.Response = attr.Value
+		case "errorCode":
+			r.ErrorCode = attr.Value
+		case "invocationResult":
+			r.InvocationResult = attr.Value
+		case "errorDescr":
+			r.ErrorDescription = attr.Value
+		}
+	}
+
+	if r.IsError() {
+		return nil
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "outConfigs":
+				err = r.decodeObjects(d)
+			case "outUnresolved":
+				var unresolved struct {
+					Dns []Dn `xml:"dn"`
+				}
+				err = d.DecodeElement(&unresolved, &t)
+				r.OutUnresolved = unresolved.Dns
+			default:
+				err = d.Skip()
+			}
+
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// decodeObjects yields each managed object until the end of the current element.
+func (r *streamResponse) decodeObjects(d *xml.Decoder) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			obj := &Object{
+				Name:    t.Name,
+				decoder: d,
+				start:   t.Copy(),
+			}
+
+			r.yielded = true
+			if err := r.fn(obj); err != nil {
+				return err
+			}
+
+			// Skip the managed object if it wasn't decoded by the caller.
+			if !obj.decoded {
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// nextStartElement returns the next start element from the given XML token stream.
+func nextStartElement(d *xml.Decoder) (xml.StartElement, error) {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return xml.StartElement{}, err
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			return start, nil
+		}
+	}
+}
+
+// StreamConfigResolveClass retrieves managed objects of the specified class and calls
+// the given function for each of them as they are read from the response.
+// Unlike ConfigResolveClass the response is never held in memory as a whole,
+// which makes it suitable for retrieving a large number of managed objects.
+// The maximum response size of the Client does not apply to streamed responses.
+func (c *Client) StreamConfigResolveClass(ctx context.Context, in ConfigResolveClassRequest, fn ObjectFunc) error {
+	resp := streamResponse{fn: fn}
+
+	return c.Request(ctx, &in, &resp)
+}
+
+// StreamConfigResolveClasses retrieves managed objects from the specified list of classes
+// and calls the given function for each of them as they are read from the response.
+func (c *Client) StreamConfigResolveClasses(ctx context.Context, in ConfigResolveClassesRequest, fn ObjectFunc) error {
+	resp := streamResponse{fn: fn}
+
+	return c.Request(ctx, &in, &resp)
+}
+
+// StreamConfigScope retrieves managed objects of the specified class below the specified DN
+// and calls the given function for each of them as they are read from the response.
+func (c *Client) StreamConfigScope(ctx context.Context, in ConfigScopeRequest, fn ObjectFunc) error {
+	resp := streamResponse{fn: fn}
+
+	return c.Request(ctx, &in, &resp)
+}
+
+// StreamConfigResolveDns retrieves managed objects for a specified list of DNs and
+// calls the given function for each of them as they are read from the response.
+// The DNs which could not be resolved are returned.
+func (c *Client) StreamConfigResolveDns(ctx context.Context, in ConfigResolveDnsRequest, fn ObjectFunc) ([]Dn, error) {
+	resp := streamResponse{fn: fn}
+	if err := c.Request(ctx, &in, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.OutUnresolved, nil
+}
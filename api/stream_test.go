@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func TestStreamConfigResolveClass(t *testing.T) {
+	var blades strings.Builder
+	for i := 1; i <= 100; i++ {
+		fmt.Fprintf(&blades, `<computeBlade dn="sys/chassis-1/blade-%d" numOfCpus="2"><computeBoard dn="sys/chassis-1/blade-%d/board"/></computeBlade>`, i, i)
+	}
+
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveClass": func(req testRequest) string {
+			if req.Attrs["classId"] == "nonExisting" {
+				return `<configResolveClass response="yes" errorCode="101" invocationResult="unidentified-fail" errorDescr="Invalid class"/>`
+			}
+			return `<configResolveClass response="yes" classId="computeBlade"><outConfigs>` + blades.String() + `</outConfigs></configResolveClass>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	// Decode every other blade, while skipping the rest
+	var decoded []mo.ComputeBlade
+	var count int
+	req := ConfigResolveClassRequest{ClassId: "computeBlade", InHierarchical: "true"}
+	err := client.StreamConfigResolveClass(ctx, req, func(obj *Object) error {
+		count++
+		if obj.Name.Local != "computeBlade" {
+			t.Fatalf("Got object %s, expect computeBlade", obj.Name.Local)
+		}
+
+		if count%2 == 1 {
+			return nil
+		}
+
+		var blade mo.ComputeBlade
+		if err := obj.Decode(&blade); err != nil {
+			return err
+		}
+
+		if blade.Dn != obj.Attr("dn") || blade.ComputeBoard.Dn != blade.Dn+"/board" {
+			t.Fatalf("Got unexpected blade %s with board %s", blade.Dn, blade.ComputeBoard.Dn)
+		}
+
+		decoded = append(decoded, blade)
+
+		return obj.Decode(&blade)
+	})
+
+	if err != ErrAlreadyDecoded {
+		t.Fatalf("Got error %v, expect %v", err, ErrAlreadyDecoded)
+	}
+
+	if count != 2 || len(decoded) != 1 {
+		t.Fatalf("Got %d objects and %d decoded blades, expect 2 and 1", count, len(decoded))
+	}
+
+	count = 0
+	err = client.StreamConfigResolveClass(ctx, req, func(obj *Object) error {
+		count++
+		if count%2 == 1 {
+			return nil
+		}
+
+		var blade mo.ComputeBlade
+		return obj.Decode(&blade)
+	})
+
+	if err != nil {
+		t.Fatalf("Cannot stream blades: %s", err)
+	}
+
+	if count != 100 {
+		t.Fatalf("Got %d objects, expect 100", count)
+	}
+
+	req.ClassId = "nonExisting"
+	err = client.StreamConfigResolveClass(ctx, req, func(obj *Object) error {
+		t.Fatalf("Got unexpected object %s", obj.Name.Local)
+		return nil
+	})
+
+	if !IsInvalidArgument(err) {
+		t.Fatalf("Got error %v, expect invalid argument", err)
+	}
+}
+
+func TestStreamConfigResolveDns(t *testing.T) {
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveDns": func(req testRequest) string {
+			return `<configResolveDns response="yes"><outUnresolved><dn value="no/such/dn"/></outUnresolved><outConfigs><topSystem dn="sys" name="ucs01"/></outConfigs></configResolveDns>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+
+	var sys mo.TopSystem
+	req := ConfigResolveDnsRequest{InDns: []Dn{NewDn("sys"), NewDn("no/such/dn")}}
+	unresolved, err := client.StreamConfigResolveDns(context.Background(), req, func(obj *Object) error {
+		return obj.Decode(&sys)
+	})
+
+	if err != nil {
+		t.Fatalf("Cannot stream DNs: %s", err)
+	}
+
+	if sys.Name != "ucs01" {
+		t.Fatalf("Got name %q, expect %q", sys.Name, "ucs01")
+	}
+
+	if len(unresolved) != 1 || unresolved[0].Value != "no/such/dn" {
+		t.Fatalf("Got unresolved DNs %+v", unresolved)
+	}
+}
+
+func TestStreamStopsOnError(t *testing.T) {
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveClasses": func(req testRequest) string {
+			return `<configResolveClasses response="yes"><outConfigs><computeBlade dn="a"/><computeBlade dn="b"/></outConfigs></configResolveClasses>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{Retry: testRetryPolicy})
+
+	stop := errors.New("stop")
+	var count int
+	err := client.StreamConfigResolveClasses(context.Background(), ConfigResolveClassesRequest{InIds: []Id{NewId("computeBlade")}}, func(obj *Object) error {
+		count++
+		return stop
+	})
+
+	if err != stop || count != 1 {
+		t.Fatalf("Got error %v after %d objects, expect %v after 1 object", err, count, stop)
+	}
+
+	if got := ts.Calls("configResolveClasses"); got != 1 {
+		t.Fatalf("Got %d requests, expect 1", got)
+	}
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// The default maximum size of a response body read from the remote endpoint.
+const defaultMaxResponseSize = 256 << 20
+
+// The maximum size of a response body snippet contained in a TransportError.
+const maxBodySnippetSize = 512
+
+// Errors which describe why a response was rejected in a TransportError.
+var (
+	ErrUnexpectedStatus      = errors.New("unexpected HTTP status")
+	ErrUnexpectedContentType = errors.New("unexpected content type")
+	ErrResponseTooLarge      = errors.New("response body too large")
+)
+
+// TransportError is returned when the remote endpoint responds with an
+// unsuccessful HTTP status code, with a body which is not an XML document
+// or with a body exceeding the maximum response size, e.g. when a load
+// balancer in front of the Cisco UCS Manager responds with an HTML page.
+type TransportError struct {
+	// Err describes why the response was rejected, which is one of
+	// ErrUnexpectedStatus, ErrUnexpectedContentType or ErrResponseTooLarge.
+	Err error
+
+	// StatusCode is the HTTP status code of the response, e.g. 503.
+	StatusCode int
+
+	// Status is the HTTP status of the response, e.g. "503 Service Unavailable".
+	Status string
+
+	// Header contains the HTTP headers of the response.
+	Header http.Header
+
+	// Body contains up to the first 512 bytes of the response body.
+	Body []byte
+}
+
+// newTransportError creates a new TransportError for the given response
+// containing a snippet of the response body read from r.
+func newTransportError(err error, resp *http.Response, r io.Reader) *TransportError {
+	body, _ := ioutil.ReadAll(io.LimitReader(r, maxBodySnippetSize))
+
+	transportErr := &TransportError{
+		Err:        err,
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header,
+		Body:       body,
+	}
+
+	return transportErr
+}
+
+// Error implements the error interface.
+func (e *TransportError) Error() string {
+	contentType := e.Header.Get("Content-Type")
+
+	return fmt.Sprintf("%s: %s (content type %q): %q", e.Err, e.Status, contentType, e.Body)
+}
+
+// Unwrap returns the reason why the response was rejected.
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// isXmlContentType returns a boolean indicating whether the given content type
+// may contain an XML document. Missing and plain text content types are
+// accepted as well, since the remote endpoint may not label XML documents.
+func isXmlContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case mediaType == "text/plain":
+		return true
+	case mediaType == "application/xhtml+xml":
+		return false
+	case strings.HasSuffix(mediaType, "/xml"), strings.HasSuffix(mediaType, "+xml"):
+		return true
+	}
+
+	return false
+}
+
+// checkResponse returns a TransportError if the given response has an
+// unsuccessful HTTP status code or a content type other than XML.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newTransportError(ErrUnexpectedStatus, resp, resp.Body)
+	}
+
+	if !isXmlContentType(resp.Header.Get("Content-Type")) {
+		return newTransportError(ErrUnexpectedContentType, resp, resp.Body)
+	}
+
+	return nil
+}
+
+// readBody reads the body of the given response, which may not exceed
+// the maximum response size configured for the Client.
+func (c *Client) readBody(resp *http.Response) ([]byte, error) {
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	maxSize := c.config.MaxResponseSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxResponseSize
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(body)) > maxSize {
+		return nil, newTransportError(ErrResponseTooLarge, resp, bytes.NewReader(body))
+	}
+
+	return body, nil
+}
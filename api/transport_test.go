@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTransportError(t *testing.T) {
+	html := "<html><body>" + strings.Repeat("Service Unavailable ", 100) + "</body></html>"
+	sys := `<configResolveDn dn="sys" response="yes"><outConfig><topSystem dn="sys" name="ucs01"/></outConfig></configResolveDn>`
+
+	var tests = []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		maxSize     int64
+		expect      error
+	}{
+		{name: "ok", status: http.StatusOK, contentType: "text/xml", body: sys},
+		{name: "no content type", status: http.StatusOK, body: sys},
+		{name: "status", status: http.StatusServiceUnavailable, contentType: "text/html", body: html, expect: ErrUnexpectedStatus},
+		{name: "content type", status: http.StatusOK, contentType: "text/html; charset=utf-8", body: html, expect: ErrUnexpectedContentType},
+		{name: "too large", status: http.StatusOK, contentType: "text/xml", body: sys, maxSize: 16, expect: ErrResponseTooLarge},
+	}
+
+	for _, test := range tests {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header()["Content-Type"] = []string{test.contentType}
+			w.WriteHeader(test.status)
+			fmt.Fprint(w, test.body)
+		}))
+
+		client, err := NewClient(Config{Endpoint: ts.URL + "/", MaxResponseSize: test.maxSize})
+		if err != nil {
+			t.Fatalf("%s: cannot create client: %s", test.name, err)
+		}
+
+		var out struct{}
+		err = client.ConfigResolveDn(context.Background(), ConfigResolveDnRequest{Dn: "sys"}, &out)
+		ts.Close()
+
+		if test.expect == nil {
+			if err != nil {
+				t.Fatalf("%s: got error %s", test.name, err)
+			}
+			continue
+		}
+
+		var transportErr *TransportError
+		if !errors.As(err, &transportErr) {
+			t.Fatalf("%s: got error %v of type %T, expect *TransportError", test.name, err, err)
+		}
+
+		if !errors.Is(err, test.expect) {
+			t.Fatalf("%s: got error %v, expect %v", test.name, err, test.expect)
+		}
+
+		if transportErr.StatusCode != test.status {
+			t.Fatalf("%s: got status code %d, expect %d", test.name, transportErr.StatusCode, test.status)
+		}
+
+		if len(transportErr.Body) == 0 || len(transportErr.Body) > maxBodySnippetSize {
+			t.Fatalf("%s: got body snippet of %d bytes", test.name, len(transportErr.Body))
+		}
+	}
+}
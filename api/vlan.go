@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// LanCloudDn is the DN of the LAN cloud.
+const LanCloudDn = "fabric/lan"
+
+// netDn returns the DN of the VLAN or VSAN with the given name within the given
+// cloud on the given fabric interconnect. VLANs and VSANs available on both
+// fabric interconnects are contained directly within the cloud.
+func netDn(cloudDn, name, switchId string) string {
+	if switchId == "" || switchId == mo.SwitchIdDual {
+		return cloudDn + "/net-" + name
+	}
+
+	return cloudDn + "/" + switchId + "/net-" + name
+}
+
+// ListVlans retrieves all VLANs, including the ones available only on a single
+// fabric interconnect, which are identified by the SwitchId field.
+func (c *Client) ListVlans(ctx context.Context) ([]mo.FabricVlan, error) {
+	req := ConfigResolveClassRequest{
+		ClassId:        "fabricVlan",
+		InHierarchical: "false",
+	}
+
+	var out struct {
+		XMLName xml.Name
+		Vlans   []mo.FabricVlan `xml:"fabricVlan"`
+	}
+
+	if err := c.ConfigResolveClass(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Vlans, nil
+}
+
+// CreateVlan creates a VLAN with the given name and id on the fabric interconnect
+// with the given switch id, e.g. mo.SwitchIdA. If the switch id is empty or
+// mo.SwitchIdDual the VLAN is created on both fabric interconnects.
+// The created VLAN is returned.
+func (c *Client) CreateVlan(ctx context.Context, name string, id int, switchId string) (*mo.FabricVlan, error) {
+	vlan := mo.FabricVlan{
+		Dn:         netDn(LanCloudDn, name, switchId),
+		Name:       name,
+		Id:         id,
+		DefaultNet: "no",
+		Sharing:    "none",
+		Status:     mo.StatusCreated,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             vlan.Dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: vlan},
+	}
+
+	var out mo.FabricVlan
+	if err := c.ConfigConfMo(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// AddVlanToGroup adds the VLAN with the given name to the VLAN group with the
+// given name. The VLAN group is created, if it does not exist yet.
+func (c *Client) AddVlanToGroup(ctx context.Context, groupName, vlanName string) error {
+	group := mo.FabricNetGroup{
+		Dn:     LanCloudDn + "/net-group-" + groupName,
+		Name:   groupName,
+		Status: mo.StatusCreatedModified,
+		PooledVlans: []mo.FabricPooledVlan{
+			{
+				Name:   vlanName,
+				Rn:     "net-" + vlanName,
+				Status: mo.StatusCreatedModified,
+			},
+		},
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             group.Dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: group},
+	}
+
+	return c.ConfigConfMo(ctx, req, nil)
+}
+
+// DeleteVlan deletes the VLAN with the given DN, e.g. as returned by ListVlans.
+func (c *Client) DeleteVlan(ctx context.Context, dn string) error {
+	vlan := mo.FabricVlan{
+		Dn:     dn,
+		Status: mo.StatusDeleted,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: vlan},
+	}
+
+	return c.ConfigConfMo(ctx, req, nil)
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func TestVlans(t *testing.T) {
+	var confMos []string
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveClass": func(req testRequest) string {
+			return `<configResolveClass response="yes" classId="fabricVlan"><outConfigs>` +
+				`<fabricVlan dn="fabric/lan/net-web" name="web" id="100" switchId="dual" sharing="none"/>` +
+				`<fabricVlan dn="fabric/lan/A/net-backup" name="backup" id="200" switchId="A" sharing="none"/>` +
+				`</outConfigs></configResolveClass>`
+		},
+		"configConfMo": func(req testRequest) string {
+			confMos = append(confMos, string(req.Body))
+			return `<configConfMo dn="` + req.Attrs["dn"] + `" response="yes"><outConfig>` +
+				`<fabricVlan dn="` + req.Attrs["dn"] + `" name="db" id="300" operState="ok"/>` +
+				`</outConfig></configConfMo>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	vlans, err := client.ListVlans(ctx)
+	if err != nil {
+		t.Fatalf("Cannot list VLANs: %s", err)
+	}
+
+	if len(vlans) != 2 || vlans[0].Id != 100 || vlans[1].SwitchId != mo.SwitchIdA {
+		t.Fatalf("Got VLANs %+v", vlans)
+	}
+
+	vlan, err := client.CreateVlan(ctx, "db", 300, "")
+	if err != nil {
+		t.Fatalf("Cannot create VLAN: %s", err)
+	}
+
+	if vlan.Dn != "fabric/lan/net-db" || vlan.Id != 300 {
+		t.Fatalf("Got VLAN %+v", vlan)
+	}
+
+	if _, err := client.CreateVlan(ctx, "db", 300, mo.SwitchIdB); err != nil {
+		t.Fatalf("Cannot create VLAN: %s", err)
+	}
+
+	if err := client.AddVlanToGroup(ctx, "databases", "db"); err != nil {
+		t.Fatalf("Cannot add VLAN to group: %s", err)
+	}
+
+	if err := client.DeleteVlan(ctx, "fabric/lan/B/net-db"); err != nil {
+		t.Fatalf("Cannot delete VLAN: %s", err)
+	}
+
+	expect := []string{
+		`<configConfMo cookie="" dn="fabric/lan/net-db" inHierarchical="false"><inConfig>` +
+			`<fabricVlan defaultNet="no" dn="fabric/lan/net-db" id="300" name="db" sharing="none" status="created"/>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/lan/B/net-db" inHierarchical="false"><inConfig>` +
+			`<fabricVlan defaultNet="no" dn="fabric/lan/B/net-db" id="300" name="db" sharing="none" status="created"/>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/lan/net-group-databases" inHierarchical="false"><inConfig>` +
+			`<fabricNetGroup dn="fabric/lan/net-group-databases" name="databases" status="created,modified">` +
+			`<fabricPooledVlan name="db" rn="net-db" status="created,modified"/>` +
+			`</fabricNetGroup>` +
+			`</inConfig></configConfMo>`,
+		`<configConfMo cookie="" dn="fabric/lan/B/net-db" inHierarchical="false"><inConfig>` +
+			`<fabricVlan dn="fabric/lan/B/net-db" status="deleted"/>` +
+			`</inConfig></configConfMo>`,
+	}
+
+	if len(confMos) != len(expect) {
+		t.Fatalf("Got %d configConfMo requests, expect %d", len(confMos), len(expect))
+	}
+
+	for i := range expect {
+		if confMos[i] != expect[i] {
+			t.Fatalf("Got request '%s', expect '%s'", confMos[i], expect[i])
+		}
+	}
+}
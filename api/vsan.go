@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+// SanCloudDn is the DN of the SAN cloud.
+const SanCloudDn = "fabric/san"
+
+// vsans is the type into which the retrieved VSANs are unmarshaled.
+type vsans struct {
+	XMLName xml.Name
+	Vsans   []mo.FabricVsan `xml:"fabricVsan"`
+}
+
+// ListVsans retrieves all VSANs, including the ones available only on a single
+// fabric interconnect, which are identified by the SwitchId field.
+func (c *Client) ListVsans(ctx context.Context) ([]mo.FabricVsan, error) {
+	req := ConfigResolveClassRequest{
+		ClassId:        "fabricVsan",
+		InHierarchical: "false",
+	}
+
+	var out vsans
+	if err := c.ConfigResolveClass(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Vsans, nil
+}
+
+// VsansOf retrieves the VSANs available on the fabric interconnect with the given
+// switch id, e.g. the Id of a mo.NetworkElement, including the VSANs available
+// on both fabric interconnects.
+func (c *Client) VsansOf(ctx context.Context, switchId string) ([]mo.FabricVsan, error) {
+	req := ConfigResolveClassRequest{
+		ClassId:        "fabricVsan",
+		InHierarchical: "false",
+		InFilter:       eqFilter("fabricVsan", "switchId", switchId, mo.SwitchIdDual),
+	}
+
+	var out vsans
+	if err := c.ConfigResolveClass(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Vsans, nil
+}
+
+// CreateVsan creates a VSAN with the given name, id and FCoE VLAN id on the fabric
+// interconnect with the given switch id, e.g. mo.SwitchIdA. If the switch id is empty
+// or mo.SwitchIdDual the VSAN is created on both fabric interconnects.
+// The created VSAN is returned.
+func (c *Client) CreateVsan(ctx context.Context, name string, id, fcoeVlan int, switchId string) (*mo.FabricVsan, error) {
+	vsan := mo.FabricVsan{
+		Dn:            netDn(SanCloudDn, name, switchId),
+		Name:          name,
+		Id:            id,
+		FcoeVlan:      fcoeVlan,
+		DefaultZoning: "disabled",
+		Status:        mo.StatusCreated,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             vsan.Dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: vsan},
+	}
+
+	var out mo.FabricVsan
+	if err := c.ConfigConfMo(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// DeleteVsan deletes the VSAN with the given DN, e.g. as returned by ListVsans.
+func (c *Client) DeleteVsan(ctx context.Context, dn string) error {
+	vsan := mo.FabricVsan{
+		Dn:     dn,
+		Status: mo.StatusDeleted,
+	}
+
+	req := ConfigConfMoRequest{
+		Dn:             dn,
+		InHierarchical: "false",
+		InConfig:       InConfig{Object: vsan},
+	}
+
+	return c.ConfigConfMo(ctx, req, nil)
+}
+
+// ListFcUplinks retrieves the physical FC uplink ports of the fabric interconnect
+// with the given switch id or of both fabric interconnects if the switch id is empty.
+// The state of a port is reported by its OperationalState field.
+func (c *Client) ListFcUplinks(ctx context.Context, switchId string) ([]mo.FcPIo, error) {
+	var switchFilter FilterAny
+	if switchId != "" {
+		switchFilter = eqFilter("fcPIo", "switchId", switchId)
+	}
+
+	req := ConfigResolveClassRequest{
+		ClassId:        "fcPIo",
+		InHierarchical: "false",
+		InFilter:       andFilter(eqFilter("fcPIo", "ifRole", "network"), switchFilter),
+	}
+
+	var out struct {
+		XMLName xml.Name
+		Ports   []mo.FcPIo `xml:"fcPIo"`
+	}
+
+	if err := c.ConfigResolveClass(ctx, req, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Ports, nil
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dnaeon/go-ucs/mo"
+)
+
+func TestVsans(t *testing.T) {
+	bodies := make(map[string][]string)
+	ts := newTestServer(t, map[string]testHandler{
+		"configResolveClass": func(req testRequest) string {
+			bodies[req.Method] = append(bodies[req.Method], string(req.Body))
+			if req.Attrs["classId"] == "fcPIo" {
+				return `<configResolveClass response="yes" classId="fcPIo"><outConfigs>` +
+					`<fcPIo dn="sys/switch-A/slot-2/switch-fc/port-1" switchId="A" ifRole="network" operState="up" operSpeed="8gbps" wwn="20:01:00:2A:6A:00:00:01"/>` +
+					`<fcPIo dn="sys/switch-A/slot-2/switch-fc/port-2" switchId="A" ifRole="network" operState="link-down" stateQual="sfp-not-present"/>` +
+					`</outConfigs></configResolveClass>`
+			}
+			return `<configResolveClass response="yes" classId="fabricVsan"><outConfigs>` +
+				`<fabricVsan dn="fabric/san/net-default" name="default" id="1" fcoeVlan="4048" switchId="dual"/>` +
+				`<fabricVsan dn="fabric/san/A/net-vsan-a" name="vsan-a" id="10" fcoeVlan="3010" switchId="A"/>` +
+				`</outConfigs></configResolveClass>`
+		},
+		"configConfMo": func(req testRequest) string {
+			bodies[req.Method] = append(bodies[req.Method], string(req.Body))
+			return `<configConfMo dn="` + req.Attrs["dn"] + `" response="yes"><outConfig>` +
+				`<fabricVsan dn="` + req.Attrs["dn"] + `" name="vsan-b" id="20" fcoeVlan="3020" switchId="B"/>` +
+				`</outConfig></configConfMo>`
+		},
+	})
+	defer ts.Close()
+
+	client := ts.NewClient(t, Config{})
+	ctx := context.Background()
+
+	vsans, err := client.VsansOf(ctx, mo.SwitchIdA)
+	if err != nil {
+		t.Fatalf("Cannot retrieve VSANs: %s", err)
+	}
+
+	if len(vsans) != 2 || vsans[1].Id != 10 || vsans[1].FcoeVlan != 3010 {
+		t.Fatalf("Got VSANs %+v", vsans)
+	}
+
+	ports, err := client.ListFcUplinks(ctx, mo.SwitchIdA)
+	if err != nil {
+		t.Fatalf("Cannot retrieve FC uplinks: %s", err)
+	}
+
+	if len(ports) != 2 || ports[0].OperationalState != "up" || ports[1].StateQualifier != "sfp-not-present" {
+		t.Fatalf("Got FC uplinks %+v", ports)
+	}
+
+	vsan, err := client.CreateVsan(ctx, "vsan-b", 20, 3020, mo.SwitchIdB)
+	if err != nil {
+		t.Fatalf("Cannot create VSAN: %s", err)
+	}
+
+	if vsan.Dn != "fabric/san/B/net-vsan-b" {
+		t.Fatalf("Got VSAN %+v", vsan)
+	}
+
+	if err := client.DeleteVsan(ctx, vsan.Dn); err != nil {
+		t.Fatalf("Cannot delete VSAN: %s", err)
+	}
+
+	expect := map[string][]string{
+		"configResolveClass": {
+			`<configResolveClass cookie="" classId="fabricVsan" inHierarchical="false"><inFilter><or>` +
+				`<eq class="fabricVsan" property="switchId" value="A"/><eq class="fabricVsan" property="switchId" value="dual"/>` +
+				`</or></inFilter></configResolveClass>`,
+			`<configResolveClass cookie="" classId="fcPIo" inHierarchical="false"><inFilter><and>` +
+				`<eq class="fcPIo" property="ifRole" value="network"/><eq class="fcPIo" property="switchId" value="A"/>` +
+				`</and></inFilter></configResolveClass>`,
+		},
+		"configConfMo": {
+			`<configConfMo cookie="" dn="fabric/san/B/net-vsan-b" inHierarchical="false"><inConfig>` +
+				`<fabricVsan defaultZoning="disabled" dn="fabric/san/B/net-vsan-b" fcoeVlan="3020" id="20" name="vsan-b" status="created"/>` +
+				`</inConfig></configConfMo>`,
+			`<configConfMo cookie="" dn="fabric/san/B/net-vsan-b" inHierarchical="false"><inConfig>` +
+				`<fabricVsan dn="fabric/san/B/net-vsan-b" status="deleted"/>` +
+				`</inConfig></configConfMo>`,
+		},
+	}
+
+	for method, requests := range expect {
+		if len(bodies[method]) != len(requests) {
+			t.Fatalf("Got %d %s requests, expect %d", len(bodies[method]), method, len(requests))
+		}
+
+		for i := range requests {
+			if bodies[method][i] != requests[i] {
+				t.Fatalf("Got request '%s', expect '%s'", bodies[method][i], requests[i])
+			}
+		}
+	}
+}
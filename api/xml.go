@@ -5,6 +5,11 @@ import (
 	"regexp"
 )
 
+// emptyElementRegexp matches an empty XML element with a start and end tag.
+// Attribute values are always quoted and escaped by xml.Marshal, so they
+// cannot contain quotes or angle brackets.
+var emptyElementRegexp = regexp.MustCompile(`<([\w:.\-]+)((?:\s+[\w:.\-]+="[^"]*")*)>\s*</([\w:.\-]+)>`)
+
 // xmlMarshalWithSelfClosingTags post-processes results from xml.Marshal into XML
 // document where empty XML elements use self-closing tags.
 //
@@ -47,8 +52,16 @@ func xmlMarshalWithSelfClosingTags(in interface{}) ([]byte, error) {
 		return nil, err
 	}
 
-	re := regexp.MustCompile(`<([^/][\w\s\"\=\-\/]*)>\s*<(\/\w*)>`)
-	newData := re.ReplaceAllString(string(data), "<$1/>")
+	newData := emptyElementRegexp.ReplaceAllStringFunc(string(data), func(element string) string {
+		m := emptyElementRegexp.FindStringSubmatch(element)
+
+		// Only replace elements, which are closed by their own end tag.
+		if m[1] != m[3] {
+			return element
+		}
+
+		return "<" + m[1] + m[2] + "/>"
+	})
 
 	return []byte(newData), nil
 }
@@ -45,6 +45,13 @@ func TestXmlMarshalWithSelfClosingTags(t *testing.T) {
 			expect: `<personEmbedded name="John Doe"><country>unknown</country></personEmbedded>`,
 		},
 
+		{
+			value:  Person{Name: "created,modified", Place: &Location{}},
+			expect: `<person name="created,modified"><place/></person>`,
+		},
+		{value: Person{Name: "192.0.2.1"}, expect: `<person name="192.0.2.1"/>`},
+		{value: Person{Name: "<a></a>"}, expect: `<person name="&lt;a&gt;&lt;/a&gt;"/>`},
+
 		// Pointers to values
 		{value: &Person{}, expect: `<person/>`},
 		{value: &Person{Name: "John Doe"}, expect: `<person name="John Doe"/>`},
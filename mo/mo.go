@@ -3,11 +3,22 @@ package mo
 import (
 	"encoding/xml"
 	"net"
+	"strings"
 )
 
 // Any represents any valid managed object.
 type Any interface{}
 
+// Values of the status attribute of managed objects, which specify the kind
+// of change when sending managed objects to the configuration methods.
+const (
+	StatusCreated         = "created"
+	StatusModified        = "modified"
+	StatusCreatedModified = "created,modified"
+	StatusDeleted         = "deleted"
+	StatusRemoved         = "removed"
+)
+
 // TopSystem provides general information about the system, such as the
 // name, IP address and current time.
 type TopSystem struct {
@@ -57,6 +68,7 @@ type CommDns struct {
 	PolicyOwner     string            `xml:"policyOwner,attr,omitempty"`
 	Port            int               `xml:"port,attr,omitempty"`
 	Proto           string            `xml:"proto,attr,omitempty"`
+	Status          string            `xml:"status,attr,omitempty"`
 	Providers       []CommDnsProvider `xml:"commDnsProvider"`
 }
 
@@ -68,6 +80,7 @@ type CommDnsProvider struct {
 	Dn          string   `xml:"dn,attr,omitempty"`
 	Hostname    string   `xml:"hostname,attr,omitempty"`
 	Name        string   `xml:"name,attr,omitempty"`
+	Status      string   `xml:"status,attr,omitempty"`
 }
 
 // VersionEp contains version information.
@@ -134,6 +147,8 @@ type EquipmentChassis struct {
 	Vid                        string               `xml:"vid,attr,omitempty"`
 	ComputeBlades              []ComputeBlade       `xml:"computeBlade"`
 	FanModules                 []EquipmentFanModule `xml:"equipmentFanModule"`
+	IOCards                    []EquipmentIOCard    `xml:"equipmentIOCard"`
+	Psus                       []EquipmentPsu       `xml:"equipmentPsu"`
 }
 
 // ComputePhysical represents a physical specification of an abstract compute item.
@@ -232,30 +247,31 @@ type ComputeItem struct {
 
 // ComputeBoard represents a motherboard contained by physical compute item.
 type ComputeBoard struct {
-	XMLName                    xml.Name          `xml:"computeBoard"`
-	CmosVoltage                string            `xml:"cmosVoltage,attr,omitempty"`
-	CpuTypeDescription         string            `xml:"cpuTypeDescription,attr,omitempty"`
-	Dn                         string            `xml:"dn,attr,omitempty"`
-	FaultQualifier             string            `xml:"faultQualifier,attr,omitempty"`
-	Id                         int               `xml:"id,attr,omitempty"`
-	LocationDn                 string            `xml:"locationDn,attr,omitempty"`
-	Model                      string            `xml:"model,attr,omitempty"`
-	OperationalPower           string            `xml:"operPower,attr,omitempty"`
-	OperationalQualifierReason string            `xml:"operQualifierReason,attr,omitempty"`
-	OperationalState           string            `xml:"operState,attr,omitempty"`
-	Operability                string            `xml:"operability,attr,omitempty"`
-	Perf                       string            `xml:"perf,attr,omitempty"`
-	Power                      string            `xml:"power,attr,omitempty"`
-	PowerUsage                 string            `xml:"powerUsage,attr,omitempty"`
-	Presence                   string            `xml:"presence,attr,omitempty"`
-	Revision                   string            `xml:"revision,attr,omitempty"`
-	Serial                     string            `xml:"serial,attr,omitempty"`
-	Thermal                    string            `xml:"thermal,attr,omitempty"`
-	Vendor                     string            `xml:"vendor,attr,omitempty"`
-	Voltage                    string            `xml:"voltage,attr,omitempty"`
-	MemoryArray                MemoryArray       `xml:"memoryArray"`
-	ProcessorUnits             []ProcessorUnit   `xml:"processorUnit"`
-	StorageController          StorageController `xml:"storageController"`
+	XMLName                    xml.Name                     `xml:"computeBoard"`
+	CmosVoltage                string                       `xml:"cmosVoltage,attr,omitempty"`
+	CpuTypeDescription         string                       `xml:"cpuTypeDescription,attr,omitempty"`
+	Dn                         string                       `xml:"dn,attr,omitempty"`
+	FaultQualifier             string                       `xml:"faultQualifier,attr,omitempty"`
+	Id                         int                          `xml:"id,attr,omitempty"`
+	LocationDn                 string                       `xml:"locationDn,attr,omitempty"`
+	Model                      string                       `xml:"model,attr,omitempty"`
+	OperationalPower           string                       `xml:"operPower,attr,omitempty"`
+	OperationalQualifierReason string                       `xml:"operQualifierReason,attr,omitempty"`
+	OperationalState           string                       `xml:"operState,attr,omitempty"`
+	Operability                string                       `xml:"operability,attr,omitempty"`
+	Perf                       string                       `xml:"perf,attr,omitempty"`
+	Power                      string                       `xml:"power,attr,omitempty"`
+	PowerUsage                 string                       `xml:"powerUsage,attr,omitempty"`
+	Presence                   string                       `xml:"presence,attr,omitempty"`
+	Revision                   string                       `xml:"revision,attr,omitempty"`
+	Serial                     string                       `xml:"serial,attr,omitempty"`
+	Thermal                    string                       `xml:"thermal,attr,omitempty"`
+	Vendor                     string                       `xml:"vendor,attr,omitempty"`
+	Voltage                    string                       `xml:"voltage,attr,omitempty"`
+	MemoryArray                MemoryArray                  `xml:"memoryArray"`
+	ProcessorUnits             []ProcessorUnit              `xml:"processorUnit"`
+	StorageController          StorageController            `xml:"storageController"`
+	FlexFlashControllers       []StorageFlexFlashController `xml:"storageFlexFlashController"`
 }
 
 // MemoryArray represents an array of memory units.
@@ -598,56 +614,60 @@ type FirmwareStatus struct {
 
 // StorageController represents a storage controller.
 type StorageController struct {
-	XMLName                    xml.Name             `xml:"storageController"`
-	AdminAction                string               `xml:"adminAction,attr,omitempty"`
-	AdminActionTrigger         string               `xml:"adminActionTrigger,attr,omitempty"`
-	ConfigState                string               `xml:"configState,attr,omitempty"`
-	ControllerOperations       string               `xml:"controllerOps,attr,omitempty"`
-	ControllerStatus           string               `xml:"controllerStatus,attr,omitempty"`
-	DefaultStripSize           string               `xml:"defaultStripSize,attr,omitempty"`
-	DeviceRaidSupport          string               `xml:"deviceRaidSupport,attr,omitempty"`
-	DiskOperations             string               `xml:"diskOps,attr,omitempty"`
-	Dn                         string               `xml:"dn,attr,omitempty"`
-	FaultMonitoring            string               `xml:"faultMonitoring,attr,omitempty"`
-	HardwareRevision           string               `xml:"hwRevision,attr,omitempty"`
-	Id                         int                  `xml:"id,attr,omitempty"`
-	IdCount                    string               `xml:"idCount,attr,omitempty"`
-	Lc                         string               `xml:"lc,attr,omitempty"`
-	LocationDn                 string               `xml:"locationDn,attr,omitempty"`
-	Mode                       string               `xml:"mode,attr,omitempty"`
-	Model                      string               `xml:"model,attr,omitempty"`
-	OnBoardMemoryPresent       string               `xml:"onBoardMemoryPresent,attr,omitempty"`
-	OnBoardMemorySize          string               `xml:"onBoardMemorySize,attr,omitempty"`
-	OobControllerId            string               `xml:"oobControllerId,attr,omitempty"`
-	OobInterfaceSupported      string               `xml:"oobInterfaceSupported,attr,omitempty"`
-	OperationalQualifierReason string               `xml:"operQualifierReason,attr,omitempty"`
-	OperationalState           string               `xml:"operState,attr,omitempty"`
-	Operability                string               `xml:"operability,attr,omitempty"`
-	OpromBootStatus            string               `xml:"opromBootStatus,attr,omitempty"`
-	PartNumber                 string               `xml:"partNumber,attr,omitempty"`
-	PciAddress                 string               `xml:"pciAddr,attr,omitempty"`
-	PciSlot                    string               `xml:"pciSlot,attr,omitempty"`
-	PciSlotRawName             string               `xml:"pciSlotRawName,attr,omitempty"`
-	Perf                       string               `xml:"perf,attr,omitempty"`
-	PinnedCacheStatus          string               `xml:"pinnedCacheStatus,attr,omitempty"`
-	Power                      string               `xml:"power,attr,omitempty"`
-	Presence                   string               `xml:"presence,attr,omitempty"`
-	RaidBatteryOperations      string               `xml:"raidBatteryOps,attr,omitempty"`
-	RaidSupport                string               `xml:"raidSupport,attr,omitempty"`
-	RebuildRate                string               `xml:"rebuildRate,attr,omitempty"`
-	Revision                   string               `xml:"revision,attr,omitempty"`
-	Serial                     string               `xml:"serial,attr,omitempty"`
-	SubOemId                   string               `xml:"subOemId,attr,omitempty"`
-	SupportedStripSizes        string               `xml:"supportedStripSizes,attr,omitempty"`
-	Thermal                    string               `xml:"thermal,attr,omitempty"`
-	Type                       string               `xml:"type,attr,omitempty"`
-	VariantType                string               `xml:"variantType,attr,omitempty"`
-	Vendor                     string               `xml:"vendor,attr,omitempty"`
-	Vid                        string               `xml:"vid,attr,omitempty"`
-	VirtualDriveOperations     string               `xml:"virtualDriveops,attr,omitempty"`
-	Voltage                    string               `xml:"voltage,attr,omitempty"`
-	ManagementController       ManagementController `xml:"mgmtController"`
-	FirmwareRunning            []FirmwareRunning    `xml:"firmwareRunning"`
+	XMLName                    xml.Name              `xml:"storageController"`
+	AdminAction                string                `xml:"adminAction,attr,omitempty"`
+	AdminActionTrigger         string                `xml:"adminActionTrigger,attr,omitempty"`
+	ConfigState                string                `xml:"configState,attr,omitempty"`
+	ControllerOperations       string                `xml:"controllerOps,attr,omitempty"`
+	ControllerStatus           string                `xml:"controllerStatus,attr,omitempty"`
+	DefaultStripSize           string                `xml:"defaultStripSize,attr,omitempty"`
+	DeviceRaidSupport          string                `xml:"deviceRaidSupport,attr,omitempty"`
+	DiskOperations             string                `xml:"diskOps,attr,omitempty"`
+	Dn                         string                `xml:"dn,attr,omitempty"`
+	FaultMonitoring            string                `xml:"faultMonitoring,attr,omitempty"`
+	HardwareRevision           string                `xml:"hwRevision,attr,omitempty"`
+	Id                         int                   `xml:"id,attr,omitempty"`
+	IdCount                    string                `xml:"idCount,attr,omitempty"`
+	Lc                         string                `xml:"lc,attr,omitempty"`
+	LocationDn                 string                `xml:"locationDn,attr,omitempty"`
+	Mode                       string                `xml:"mode,attr,omitempty"`
+	Model                      string                `xml:"model,attr,omitempty"`
+	OnBoardMemoryPresent       string                `xml:"onBoardMemoryPresent,attr,omitempty"`
+	OnBoardMemorySize          string                `xml:"onBoardMemorySize,attr,omitempty"`
+	OobControllerId            string                `xml:"oobControllerId,attr,omitempty"`
+	OobInterfaceSupported      string                `xml:"oobInterfaceSupported,attr,omitempty"`
+	OperationalQualifierReason string                `xml:"operQualifierReason,attr,omitempty"`
+	OperationalState           string                `xml:"operState,attr,omitempty"`
+	Operability                string                `xml:"operability,attr,omitempty"`
+	OpromBootStatus            string                `xml:"opromBootStatus,attr,omitempty"`
+	PartNumber                 string                `xml:"partNumber,attr,omitempty"`
+	PciAddress                 string                `xml:"pciAddr,attr,omitempty"`
+	PciSlot                    string                `xml:"pciSlot,attr,omitempty"`
+	PciSlotRawName             string                `xml:"pciSlotRawName,attr,omitempty"`
+	Perf                       string                `xml:"perf,attr,omitempty"`
+	PinnedCacheStatus          string                `xml:"pinnedCacheStatus,attr,omitempty"`
+	Power                      string                `xml:"power,attr,omitempty"`
+	Presence                   string                `xml:"presence,attr,omitempty"`
+	RaidBatteryOperations      string                `xml:"raidBatteryOps,attr,omitempty"`
+	RaidSupport                string                `xml:"raidSupport,attr,omitempty"`
+	RebuildRate                string                `xml:"rebuildRate,attr,omitempty"`
+	Revision                   string                `xml:"revision,attr,omitempty"`
+	Serial                     string                `xml:"serial,attr,omitempty"`
+	SubOemId                   string                `xml:"subOemId,attr,omitempty"`
+	SupportedStripSizes        string                `xml:"supportedStripSizes,attr,omitempty"`
+	Thermal                    string                `xml:"thermal,attr,omitempty"`
+	Type                       string                `xml:"type,attr,omitempty"`
+	VariantType                string                `xml:"variantType,attr,omitempty"`
+	Vendor                     string                `xml:"vendor,attr,omitempty"`
+	Vid                        string                `xml:"vid,attr,omitempty"`
+	VirtualDriveOperations     string                `xml:"virtualDriveops,attr,omitempty"`
+	Voltage                    string                `xml:"voltage,attr,omitempty"`
+	ManagementController       ManagementController  `xml:"mgmtController"`
+	FirmwareRunning            []FirmwareRunning     `xml:"firmwareRunning"`
+	LocalDisks                 []StorageLocalDisk    `xml:"storageLocalDisk"`
+	VirtualDrives              []StorageVirtualDrive `xml:"storageVirtualDrive"`
+	LocalLuns                  []StorageLocalLun     `xml:"storageLocalLun"`
+	RaidBattery                *StorageRaidBattery   `xml:"storageRaidBattery,omitempty"`
 }
 
 // BiosUnit represents a BIOS unit.
@@ -706,36 +726,1395 @@ type StorageItem struct {
 
 // NetworkElement represents a physical network element, such as a Fabric Interconnect.
 type NetworkElement struct {
-	XMLName                   xml.Name             `xml:"networkElement"`
-	AdminEvacState            string               `xml:"adminEvacState,attr,omitempty"`
-	AdminInbandInterfaceState string               `xml:"adminInbandIfState,attr,omitempty"`
-	ChildAction               string               `xml:"childAction,attr,omitempty"`
-	DiffMemory                int                  `xml:"diffMemory,attr,omitempty"`
-	Dn                        string               `xml:"dn,attr,omitempty"`
-	ExpectedMemory            int                  `xml:"expectedMemory,attr,omitempty"`
-	FltAggr                   int                  `xml:"fltAggr,attr,omitempty"`
-	ForceEvac                 string               `xml:"forceEvac,attr,omitempty"`
-	Id                        string               `xml:"id,attr,omitempty"`
-	InbandInterfaceGateway    net.IP               `xml:"inbandIfGw,attr,omitempty"`
-	InbandInterfaceIp         net.IP               `xml:"inbandIfIp,attr,omitempty"`
-	InbandInterfaceNetmask    net.IP               `xml:"inbandIfMask,attr,omitempty"`
-	InbandInterfaceVnet       int                  `xml:"inbandIfVnet,attr,omitempty"`
-	InventoryStatus           string               `xml:"inventoryStatus,attr,omitempty"`
-	MinActiveFan              int                  `xml:"minActiveFan,attr,omitempty"`
-	Model                     string               `xml:"model,attr,omitempty"`
-	OobInterfaceGateway       net.IP               `xml:"oobIfGw,attr,omitempty"`
-	OobInterfaceIp            net.IP               `xml:"oobIfIp,attr,omitempty"`
-	OobInterfaceNetmask       net.IP               `xml:"oobIfMask,attr,omitempty"`
-	OobInterfaceMac           string               `xml:"oobIfMac,attr,omitempty"`
-	OperEvacState             string               `xml:"operEvacState,attr,omitempty"`
-	Operability               string               `xml:"operability,attr,omitempty"`
-	Revision                  string               `xml:"revision,attr,omitempty"`
-	Serial                    string               `xml:"serial,attr,omitempty"`
-	ShutdownFanRemoval        string               `xml:"shutdownFanRemoveal,attr,omitempty"`
-	Thermal                   string               `xml:"thermal,attr,omitempty"`
-	TotalMemory               int                  `xml:"totalMemory,attr,omitempty"`
-	Vendor                    string               `xml:"vendor,attr,omitempty"`
-	FanModules                []EquipmentFanModule `xml:"equipmentFanModule"`
-	ManagementController      ManagementController `xml:"mgmtController"`
-	StorageItems              []StorageItem        `xml:"storageItem"`
+	XMLName                   xml.Name              `xml:"networkElement"`
+	AdminEvacState            string                `xml:"adminEvacState,attr,omitempty"`
+	AdminInbandInterfaceState string                `xml:"adminInbandIfState,attr,omitempty"`
+	ChildAction               string                `xml:"childAction,attr,omitempty"`
+	DiffMemory                int                   `xml:"diffMemory,attr,omitempty"`
+	Dn                        string                `xml:"dn,attr,omitempty"`
+	ExpectedMemory            int                   `xml:"expectedMemory,attr,omitempty"`
+	FltAggr                   int                   `xml:"fltAggr,attr,omitempty"`
+	ForceEvac                 string                `xml:"forceEvac,attr,omitempty"`
+	Id                        string                `xml:"id,attr,omitempty"`
+	InbandInterfaceGateway    net.IP                `xml:"inbandIfGw,attr,omitempty"`
+	InbandInterfaceIp         net.IP                `xml:"inbandIfIp,attr,omitempty"`
+	InbandInterfaceNetmask    net.IP                `xml:"inbandIfMask,attr,omitempty"`
+	InbandInterfaceVnet       int                   `xml:"inbandIfVnet,attr,omitempty"`
+	InventoryStatus           string                `xml:"inventoryStatus,attr,omitempty"`
+	MinActiveFan              int                   `xml:"minActiveFan,attr,omitempty"`
+	Model                     string                `xml:"model,attr,omitempty"`
+	OobInterfaceGateway       net.IP                `xml:"oobIfGw,attr,omitempty"`
+	OobInterfaceIp            net.IP                `xml:"oobIfIp,attr,omitempty"`
+	OobInterfaceNetmask       net.IP                `xml:"oobIfMask,attr,omitempty"`
+	OobInterfaceMac           string                `xml:"oobIfMac,attr,omitempty"`
+	OperEvacState             string                `xml:"operEvacState,attr,omitempty"`
+	Operability               string                `xml:"operability,attr,omitempty"`
+	Revision                  string                `xml:"revision,attr,omitempty"`
+	Serial                    string                `xml:"serial,attr,omitempty"`
+	ShutdownFanRemoval        string                `xml:"shutdownFanRemoveal,attr,omitempty"`
+	Thermal                   string                `xml:"thermal,attr,omitempty"`
+	TotalMemory               int                   `xml:"totalMemory,attr,omitempty"`
+	Vendor                    string                `xml:"vendor,attr,omitempty"`
+	FanModules                []EquipmentFanModule  `xml:"equipmentFanModule"`
+	ManagementController      ManagementController  `xml:"mgmtController"`
+	StorageItems              []StorageItem         `xml:"storageItem"`
+	SwitchCards               []EquipmentSwitchCard `xml:"equipmentSwitchCard"`
+	Psus                      []EquipmentPsu        `xml:"equipmentPsu"`
+}
+
+// Severities of a fault, which are ordered from the most to the least severe.
+const (
+	FaultSeverityCritical  = "critical"
+	FaultSeverityMajor     = "major"
+	FaultSeverityMinor     = "minor"
+	FaultSeverityWarning   = "warning"
+	FaultSeverityInfo      = "info"
+	FaultSeverityCondition = "condition"
+	FaultSeverityCleared   = "cleared"
+)
+
+// FaultInst represents a fault raised against a managed object.
+// The fault is contained within the affected managed object.
+type FaultInst struct {
+	XMLName         xml.Name `xml:"faultInst"`
+	Ack             string   `xml:"ack,attr,omitempty"`
+	Cause           string   `xml:"cause,attr,omitempty"`
+	ChangeSet       string   `xml:"changeSet,attr,omitempty"`
+	ChildAction     string   `xml:"childAction,attr,omitempty"`
+	Code            string   `xml:"code,attr,omitempty"`
+	Created         string   `xml:"created,attr,omitempty"`
+	Description     string   `xml:"descr,attr,omitempty"`
+	Dn              string   `xml:"dn,attr,omitempty"`
+	HighestSeverity string   `xml:"highestSeverity,attr,omitempty"`
+	Id              int      `xml:"id,attr,omitempty"`
+	LastTransition  string   `xml:"lastTransition,attr,omitempty"`
+	Lc              string   `xml:"lc,attr,omitempty"`
+	Occur           int      `xml:"occur,attr,omitempty"`
+	OrigSeverity    string   `xml:"origSeverity,attr,omitempty"`
+	PrevSeverity    string   `xml:"prevSeverity,attr,omitempty"`
+	Rule            string   `xml:"rule,attr,omitempty"`
+	Severity        string   `xml:"severity,attr,omitempty"`
+	Status          string   `xml:"status,attr,omitempty"`
+	Tags            string   `xml:"tags,attr,omitempty"`
+	Type            string   `xml:"type,attr,omitempty"`
+}
+
+// AffectedDn returns the DN of the managed object affected by the fault.
+func (f FaultInst) AffectedDn() string {
+	return ParentDn(f.Dn)
+}
+
+// SplitDn splits the given DN into the relative names along its path, e.g.
+// "org-root/ls-web01" is split into "org-root" and "ls-web01". Relative names
+// enclosed in brackets, such as "ls-[web/01]", may contain slashes.
+func SplitDn(dn string) []string {
+	var rns []string
+
+	depth, start := 0, 0
+	for i := 0; i < len(dn); i++ {
+		switch dn[i] {
+		case '[':
+			depth++
+		case ']':
+			if depth > 0 {
+				depth--
+			}
+		case '/':
+			if depth == 0 {
+				rns = append(rns, dn[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if start < len(dn) {
+		rns = append(rns, dn[start:])
+	}
+
+	return rns
+}
+
+// ParentDn returns the DN of the parent of the managed object with the given DN.
+func ParentDn(dn string) string {
+	rns := SplitDn(dn)
+	if len(rns) < 2 {
+		return ""
+	}
+
+	return strings.Join(rns[:len(rns)-1], "/")
+}
+
+// Types of a service profile.
+const (
+	LsServerTypeInstance         = "instance"
+	LsServerTypeInitialTemplate  = "initial-template"
+	LsServerTypeUpdatingTemplate = "updating-template"
+)
+
+// LsServer represents a service profile, which defines the identity and
+// configuration of a server, and which may be associated to a physical server.
+type LsServer struct {
+	XMLName                 xml.Name       `xml:"lsServer"`
+	AgentPolicyName         string         `xml:"agentPolicyName,attr,omitempty"`
+	AssignState             string         `xml:"assignState,attr,omitempty"`
+	AssocState              string         `xml:"assocState,attr,omitempty"`
+	BiosProfileName         string         `xml:"biosProfileName,attr,omitempty"`
+	BootPolicyName          string         `xml:"bootPolicyName,attr,omitempty"`
+	ChildAction             string         `xml:"childAction,attr,omitempty"`
+	ConfigQualifier         string         `xml:"configQualifier,attr,omitempty"`
+	ConfigState             string         `xml:"configState,attr,omitempty"`
+	Description             string         `xml:"descr,attr,omitempty"`
+	Dn                      string         `xml:"dn,attr,omitempty"`
+	DynamicConPolicyName    string         `xml:"dynamicConPolicyName,attr,omitempty"`
+	ExtIPPoolName           string         `xml:"extIPPoolName,attr,omitempty"`
+	ExtIPState              string         `xml:"extIPState,attr,omitempty"`
+	FltAggr                 int            `xml:"fltAggr,attr,omitempty"`
+	HostFwPolicyName        string         `xml:"hostFwPolicyName,attr,omitempty"`
+	IdentPoolName           string         `xml:"identPoolName,attr,omitempty"`
+	IntId                   string         `xml:"intId,attr,omitempty"`
+	KvmMgmtPolicyName       string         `xml:"kvmMgmtPolicyName,attr,omitempty"`
+	LocalDiskPolicyName     string         `xml:"localDiskPolicyName,attr,omitempty"`
+	MaintPolicyName         string         `xml:"maintPolicyName,attr,omitempty"`
+	MgmtAccessPolicyName    string         `xml:"mgmtAccessPolicyName,attr,omitempty"`
+	MgmtFwPolicyName        string         `xml:"mgmtFwPolicyName,attr,omitempty"`
+	Name                    string         `xml:"name,attr,omitempty"`
+	OperationalSrcTemplName string         `xml:"operSrcTemplName,attr,omitempty"`
+	OperationalState        string         `xml:"operState,attr,omitempty"`
+	Owner                   string         `xml:"owner,attr,omitempty"`
+	PnDn                    string         `xml:"pnDn,attr,omitempty"`
+	PolicyLevel             int            `xml:"policyLevel,attr,omitempty"`
+	PolicyOwner             string         `xml:"policyOwner,attr,omitempty"`
+	PowerPolicyName         string         `xml:"powerPolicyName,attr,omitempty"`
+	ResolveRemote           string         `xml:"resolveRemote,attr,omitempty"`
+	Rn                      string         `xml:"rn,attr,omitempty"`
+	ScrubPolicyName         string         `xml:"scrubPolicyName,attr,omitempty"`
+	SolPolicyName           string         `xml:"solPolicyName,attr,omitempty"`
+	SrcTemplName            string         `xml:"srcTemplName,attr,omitempty"`
+	StatsPolicyName         string         `xml:"statsPolicyName,attr,omitempty"`
+	Status                  string         `xml:"status,attr,omitempty"`
+	Type                    string         `xml:"type,attr,omitempty"`
+	UserLabel               string         `xml:"usrLbl,attr,omitempty"`
+	Uuid                    string         `xml:"uuid,attr,omitempty"`
+	UuidSuffix              string         `xml:"uuidSuffix,attr,omitempty"`
+	VconProfileName         string         `xml:"vconProfileName,attr,omitempty"`
+	VnicEthers              []VnicEther    `xml:"vnicEther"`
+	VnicFcs                 []VnicFc       `xml:"vnicFc"`
+	Binding                 *LsBinding     `xml:"lsBinding,omitempty"`
+	Requirement             *LsRequirement `xml:"lsRequirement,omitempty"`
+	Power                   *LsPower       `xml:"lsPower,omitempty"`
+}
+
+// LsBinding represents the association of a service profile to a physical server.
+type LsBinding struct {
+	XMLName           xml.Name `xml:"lsBinding"`
+	AssignedToDn      string   `xml:"assignedToDn,attr,omitempty"`
+	ChildAction       string   `xml:"childAction,attr,omitempty"`
+	ComputeEpDn       string   `xml:"computeEpDn,attr,omitempty"`
+	Dn                string   `xml:"dn,attr,omitempty"`
+	Issues            string   `xml:"issues,attr,omitempty"`
+	Name              string   `xml:"name,attr,omitempty"`
+	PnDn              string   `xml:"pnDn,attr,omitempty"`
+	RestrictMigration string   `xml:"restrictMigration,attr,omitempty"`
+	Rn                string   `xml:"rn,attr,omitempty"`
+	Status            string   `xml:"status,attr,omitempty"`
+}
+
+// LsRequirement represents the association of a service profile to a
+// physical server, which is selected from a server pool.
+type LsRequirement struct {
+	XMLName           xml.Name `xml:"lsRequirement"`
+	AssignedToDn      string   `xml:"assignedToDn,attr,omitempty"`
+	ChildAction       string   `xml:"childAction,attr,omitempty"`
+	ComputeEpDn       string   `xml:"computeEpDn,attr,omitempty"`
+	Dn                string   `xml:"dn,attr,omitempty"`
+	Issues            string   `xml:"issues,attr,omitempty"`
+	Name              string   `xml:"name,attr,omitempty"`
+	PnDn              string   `xml:"pnDn,attr,omitempty"`
+	Qualifier         string   `xml:"qualifier,attr,omitempty"`
+	RestrictMigration string   `xml:"restrictMigration,attr,omitempty"`
+	Rn                string   `xml:"rn,attr,omitempty"`
+	Status            string   `xml:"status,attr,omitempty"`
+}
+
+// LsPower represents the desired power state of a service profile.
+type LsPower struct {
+	XMLName     xml.Name `xml:"lsPower"`
+	ChildAction string   `xml:"childAction,attr,omitempty"`
+	Dn          string   `xml:"dn,attr,omitempty"`
+	Rn          string   `xml:"rn,attr,omitempty"`
+	State       string   `xml:"state,attr,omitempty"`
+	Status      string   `xml:"status,attr,omitempty"`
+}
+
+// OrgOrg represents an organization, which contains the policies, pools and
+// service profiles of a tenant. Organizations may contain sub-organizations.
+type OrgOrg struct {
+	XMLName              xml.Name              `xml:"orgOrg"`
+	ChildAction          string                `xml:"childAction,attr,omitempty"`
+	Description          string                `xml:"descr,attr,omitempty"`
+	Dn                   string                `xml:"dn,attr,omitempty"`
+	FltAggr              int                   `xml:"fltAggr,attr,omitempty"`
+	Level                string                `xml:"level,attr,omitempty"`
+	Name                 string                `xml:"name,attr,omitempty"`
+	PermAccess           string                `xml:"permAccess,attr,omitempty"`
+	Rn                   string                `xml:"rn,attr,omitempty"`
+	Status               string                `xml:"status,attr,omitempty"`
+	Orgs                 []OrgOrg              `xml:"orgOrg"`
+	LsServers            []LsServer            `xml:"lsServer"`
+	VnicLanConnTemplates []VnicLanConnTemplate `xml:"vnicLanConnTempl"`
+	VnicSanConnTemplates []VnicSanConnTemplate `xml:"vnicSanConnTempl"`
+	MacPools             []MacpoolPool         `xml:"macpoolPool"`
+	UuidPools            []UuidpoolPool        `xml:"uuidpoolPool"`
+	WwnPools             []FcpoolInitiators    `xml:"fcpoolInitiators"`
+	IpPools              []IppoolPool          `xml:"ippoolPool"`
+}
+
+// VnicEther represents a vNIC of a service profile, which connects the
+// server to the VLANs specified by its interfaces.
+type VnicEther struct {
+	XMLName                  xml.Name      `xml:"vnicEther"`
+	AdaptorProfileName       string        `xml:"adaptorProfileName,attr,omitempty"`
+	Addr                     string        `xml:"addr,attr,omitempty"`
+	AdminVcon                string        `xml:"adminVcon,attr,omitempty"`
+	CdnName                  string        `xml:"cdnName,attr,omitempty"`
+	ChildAction              string        `xml:"childAction,attr,omitempty"`
+	ConfigQualifier          string        `xml:"configQualifier,attr,omitempty"`
+	ConfigState              string        `xml:"configState,attr,omitempty"`
+	Dn                       string        `xml:"dn,attr,omitempty"`
+	DynamicId                int           `xml:"dynamicId,attr,omitempty"`
+	EquipmentDn              string        `xml:"equipmentDn,attr,omitempty"`
+	IdentPoolName            string        `xml:"identPoolName,attr,omitempty"`
+	Mtu                      int           `xml:"mtu,attr,omitempty"`
+	Name                     string        `xml:"name,attr,omitempty"`
+	NwCtrlPolicyName         string        `xml:"nwCtrlPolicyName,attr,omitempty"`
+	NwTemplName              string        `xml:"nwTemplName,attr,omitempty"`
+	OperationalNwTemplName   string        `xml:"operNwTemplName,attr,omitempty"`
+	OperationalOrder         string        `xml:"operOrder,attr,omitempty"`
+	OperationalSpeed         string        `xml:"operSpeed,attr,omitempty"`
+	Order                    string        `xml:"order,attr,omitempty"`
+	Owner                    string        `xml:"owner,attr,omitempty"`
+	PinToGroupName           string        `xml:"pinToGroupName,attr,omitempty"`
+	QosPolicyName            string        `xml:"qosPolicyName,attr,omitempty"`
+	Rn                       string        `xml:"rn,attr,omitempty"`
+	StatsPolicyName          string        `xml:"statsPolicyName,attr,omitempty"`
+	Status                   string        `xml:"status,attr,omitempty"`
+	SwitchId                 string        `xml:"switchId,attr,omitempty"`
+	Type                     string        `xml:"type,attr,omitempty"`
+	VirtualizationPreference string        `xml:"virtualizationPreference,attr,omitempty"`
+	Interfaces               []VnicEtherIf `xml:"vnicEtherIf"`
+}
+
+// VnicEtherIf represents the connection of a vNIC or a LAN connectivity template to a VLAN.
+type VnicEtherIf struct {
+	XMLName             xml.Name `xml:"vnicEtherIf"`
+	Addr                string   `xml:"addr,attr,omitempty"`
+	ChildAction         string   `xml:"childAction,attr,omitempty"`
+	ConfigQualifier     string   `xml:"configQualifier,attr,omitempty"`
+	DefaultNet          string   `xml:"defaultNet,attr,omitempty"`
+	Dn                  string   `xml:"dn,attr,omitempty"`
+	Name                string   `xml:"name,attr,omitempty"`
+	OperationalVnetDn   string   `xml:"operVnetDn,attr,omitempty"`
+	OperationalVnetName string   `xml:"operVnetName,attr,omitempty"`
+	Owner               string   `xml:"owner,attr,omitempty"`
+	PubNwId             int      `xml:"pubNwId,attr,omitempty"`
+	Rn                  string   `xml:"rn,attr,omitempty"`
+	Sharing             string   `xml:"sharing,attr,omitempty"`
+	Status              string   `xml:"status,attr,omitempty"`
+	SwitchId            string   `xml:"switchId,attr,omitempty"`
+	Type                string   `xml:"type,attr,omitempty"`
+	Vnet                int      `xml:"vnet,attr,omitempty"`
+}
+
+// VnicFc represents a vHBA of a service profile, which connects the
+// server to the VSAN specified by its interface.
+type VnicFc struct {
+	XMLName                xml.Name   `xml:"vnicFc"`
+	AdaptorProfileName     string     `xml:"adaptorProfileName,attr,omitempty"`
+	Addr                   string     `xml:"addr,attr,omitempty"`
+	AdminVcon              string     `xml:"adminVcon,attr,omitempty"`
+	ChildAction            string     `xml:"childAction,attr,omitempty"`
+	ConfigQualifier        string     `xml:"configQualifier,attr,omitempty"`
+	ConfigState            string     `xml:"configState,attr,omitempty"`
+	Dn                     string     `xml:"dn,attr,omitempty"`
+	EquipmentDn            string     `xml:"equipmentDn,attr,omitempty"`
+	IdentPoolName          string     `xml:"identPoolName,attr,omitempty"`
+	MaxDataFieldSize       int        `xml:"maxDataFieldSize,attr,omitempty"`
+	Name                   string     `xml:"name,attr,omitempty"`
+	NwTemplName            string     `xml:"nwTemplName,attr,omitempty"`
+	OperationalNwTemplName string     `xml:"operNwTemplName,attr,omitempty"`
+	OperationalOrder       string     `xml:"operOrder,attr,omitempty"`
+	OperationalSpeed       string     `xml:"operSpeed,attr,omitempty"`
+	Order                  string     `xml:"order,attr,omitempty"`
+	Owner                  string     `xml:"owner,attr,omitempty"`
+	PersBind               string     `xml:"persBind,attr,omitempty"`
+	PersBindClear          string     `xml:"persBindClear,attr,omitempty"`
+	PinToGroupName         string     `xml:"pinToGroupName,attr,omitempty"`
+	QosPolicyName          string     `xml:"qosPolicyName,attr,omitempty"`
+	Rn                     string     `xml:"rn,attr,omitempty"`
+	StatsPolicyName        string     `xml:"statsPolicyName,attr,omitempty"`
+	Status                 string     `xml:"status,attr,omitempty"`
+	SwitchId               string     `xml:"switchId,attr,omitempty"`
+	Type                   string     `xml:"type,attr,omitempty"`
+	Interfaces             []VnicFcIf `xml:"vnicFcIf"`
+}
+
+// VnicFcIf represents the connection of a vHBA or a SAN connectivity template to a VSAN.
+type VnicFcIf struct {
+	XMLName             xml.Name `xml:"vnicFcIf"`
+	ChildAction         string   `xml:"childAction,attr,omitempty"`
+	ConfigQualifier     string   `xml:"configQualifier,attr,omitempty"`
+	Dn                  string   `xml:"dn,attr,omitempty"`
+	Initiator           string   `xml:"initiator,attr,omitempty"`
+	Name                string   `xml:"name,attr,omitempty"`
+	OperationalVnetDn   string   `xml:"operVnetDn,attr,omitempty"`
+	OperationalVnetName string   `xml:"operVnetName,attr,omitempty"`
+	Owner               string   `xml:"owner,attr,omitempty"`
+	Rn                  string   `xml:"rn,attr,omitempty"`
+	Sharing             string   `xml:"sharing,attr,omitempty"`
+	Status              string   `xml:"status,attr,omitempty"`
+	SwitchId            string   `xml:"switchId,attr,omitempty"`
+	Type                string   `xml:"type,attr,omitempty"`
+	Vnet                int      `xml:"vnet,attr,omitempty"`
+}
+
+// VnicLanConnTemplate represents a vNIC template, which defines the
+// configuration of the vNICs created from it.
+type VnicLanConnTemplate struct {
+	XMLName                 xml.Name      `xml:"vnicLanConnTempl"`
+	AdminCdnName            string        `xml:"adminCdnName,attr,omitempty"`
+	CdnSource               string        `xml:"cdnSource,attr,omitempty"`
+	ChildAction             string        `xml:"childAction,attr,omitempty"`
+	Description             string        `xml:"descr,attr,omitempty"`
+	Dn                      string        `xml:"dn,attr,omitempty"`
+	IdentPoolName           string        `xml:"identPoolName,attr,omitempty"`
+	IntId                   string        `xml:"intId,attr,omitempty"`
+	Mtu                     int           `xml:"mtu,attr,omitempty"`
+	Name                    string        `xml:"name,attr,omitempty"`
+	NwCtrlPolicyName        string        `xml:"nwCtrlPolicyName,attr,omitempty"`
+	PeerRedundancyTemplName string        `xml:"peerRedundancyTemplName,attr,omitempty"`
+	PinToGroupName          string        `xml:"pinToGroupName,attr,omitempty"`
+	PolicyLevel             int           `xml:"policyLevel,attr,omitempty"`
+	PolicyOwner             string        `xml:"policyOwner,attr,omitempty"`
+	QosPolicyName           string        `xml:"qosPolicyName,attr,omitempty"`
+	RedundancyPairType      string        `xml:"redundancyPairType,attr,omitempty"`
+	Rn                      string        `xml:"rn,attr,omitempty"`
+	StatsPolicyName         string        `xml:"statsPolicyName,attr,omitempty"`
+	Status                  string        `xml:"status,attr,omitempty"`
+	SwitchId                string        `xml:"switchId,attr,omitempty"`
+	Target                  string        `xml:"target,attr,omitempty"`
+	TemplType               string        `xml:"templType,attr,omitempty"`
+	Interfaces              []VnicEtherIf `xml:"vnicEtherIf"`
+}
+
+// VnicSanConnTemplate represents a vHBA template, which defines the
+// configuration of the vHBAs created from it.
+type VnicSanConnTemplate struct {
+	XMLName          xml.Name   `xml:"vnicSanConnTempl"`
+	ChildAction      string     `xml:"childAction,attr,omitempty"`
+	Description      string     `xml:"descr,attr,omitempty"`
+	Dn               string     `xml:"dn,attr,omitempty"`
+	IdentPoolName    string     `xml:"identPoolName,attr,omitempty"`
+	IntId            string     `xml:"intId,attr,omitempty"`
+	MaxDataFieldSize int        `xml:"maxDataFieldSize,attr,omitempty"`
+	Name             string     `xml:"name,attr,omitempty"`
+	PinToGroupName   string     `xml:"pinToGroupName,attr,omitempty"`
+	PolicyLevel      int        `xml:"policyLevel,attr,omitempty"`
+	PolicyOwner      string     `xml:"policyOwner,attr,omitempty"`
+	QosPolicyName    string     `xml:"qosPolicyName,attr,omitempty"`
+	Rn               string     `xml:"rn,attr,omitempty"`
+	StatsPolicyName  string     `xml:"statsPolicyName,attr,omitempty"`
+	Status           string     `xml:"status,attr,omitempty"`
+	SwitchId         string     `xml:"switchId,attr,omitempty"`
+	TemplType        string     `xml:"templType,attr,omitempty"`
+	Interfaces       []VnicFcIf `xml:"vnicFcIf"`
+}
+
+// Identifiers of the fabric interconnects, which are used by the switchId
+// attribute of managed objects. Managed objects present on both fabric
+// interconnects are identified as dual.
+const (
+	SwitchIdA    = "A"
+	SwitchIdB    = "B"
+	SwitchIdDual = "dual"
+)
+
+// FabricLanCloud represents the LAN cloud, which contains the VLANs
+// available on both fabric interconnects.
+type FabricLanCloud struct {
+	XMLName         xml.Name         `xml:"fabricLanCloud"`
+	ChildAction     string           `xml:"childAction,attr,omitempty"`
+	Dn              string           `xml:"dn,attr,omitempty"`
+	MacAging        string           `xml:"macAging,attr,omitempty"`
+	Mode            string           `xml:"mode,attr,omitempty"`
+	Rn              string           `xml:"rn,attr,omitempty"`
+	Status          string           `xml:"status,attr,omitempty"`
+	VlanCompression string           `xml:"vlanCompression,attr,omitempty"`
+	EthLans         []FabricEthLan   `xml:"fabricEthLan"`
+	Vlans           []FabricVlan     `xml:"fabricVlan"`
+	NetGroups       []FabricNetGroup `xml:"fabricNetGroup"`
+}
+
+// FabricEthLan represents the LAN cloud of a single fabric interconnect,
+// which contains the VLANs available only on this fabric interconnect.
+type FabricEthLan struct {
+	XMLName     xml.Name     `xml:"fabricEthLan"`
+	ChildAction string       `xml:"childAction,attr,omitempty"`
+	Dn          string       `xml:"dn,attr,omitempty"`
+	Id          string       `xml:"id,attr,omitempty"`
+	Locale      string       `xml:"locale,attr,omitempty"`
+	Name        string       `xml:"name,attr,omitempty"`
+	Rn          string       `xml:"rn,attr,omitempty"`
+	Status      string       `xml:"status,attr,omitempty"`
+	Transport   string       `xml:"transport,attr,omitempty"`
+	Type        string       `xml:"type,attr,omitempty"`
+	Vlans       []FabricVlan `xml:"fabricVlan"`
+}
+
+// FabricVlan represents a VLAN.
+type FabricVlan struct {
+	XMLName          xml.Name `xml:"fabricVlan"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Cloud            string   `xml:"cloud,attr,omitempty"`
+	CompressionType  string   `xml:"compressionType,attr,omitempty"`
+	ConfigIssues     string   `xml:"configIssues,attr,omitempty"`
+	DefaultNet       string   `xml:"defaultNet,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	FltAggr          int      `xml:"fltAggr,attr,omitempty"`
+	Global           int      `xml:"global,attr,omitempty"`
+	Id               int      `xml:"id,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	Local            int      `xml:"local,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	McastPolicyName  string   `xml:"mcastPolicyName,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PolicyOwner      string   `xml:"policyOwner,attr,omitempty"`
+	PubNwDn          string   `xml:"pubNwDn,attr,omitempty"`
+	PubNwId          int      `xml:"pubNwId,attr,omitempty"`
+	PubNwName        string   `xml:"pubNwName,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	Sharing          string   `xml:"sharing,attr,omitempty"`
+	Status           string   `xml:"status,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+}
+
+// FabricNetGroup represents a VLAN group, which contains a set of VLANs.
+type FabricNetGroup struct {
+	XMLName      xml.Name           `xml:"fabricNetGroup"`
+	ChildAction  string             `xml:"childAction,attr,omitempty"`
+	ConfigIssues string             `xml:"configIssues,attr,omitempty"`
+	Dn           string             `xml:"dn,attr,omitempty"`
+	Name         string             `xml:"name,attr,omitempty"`
+	NativeNet    string             `xml:"nativeNet,attr,omitempty"`
+	NetworkType  string             `xml:"networkType,attr,omitempty"`
+	PolicyOwner  string             `xml:"policyOwner,attr,omitempty"`
+	Rn           string             `xml:"rn,attr,omitempty"`
+	Status       string             `xml:"status,attr,omitempty"`
+	Type         string             `xml:"type,attr,omitempty"`
+	PooledVlans  []FabricPooledVlan `xml:"fabricPooledVlan"`
+}
+
+// FabricPooledVlan represents a VLAN, which is a member of a VLAN group.
+type FabricPooledVlan struct {
+	XMLName     xml.Name `xml:"fabricPooledVlan"`
+	ChildAction string   `xml:"childAction,attr,omitempty"`
+	Dn          string   `xml:"dn,attr,omitempty"`
+	Name        string   `xml:"name,attr,omitempty"`
+	Rn          string   `xml:"rn,attr,omitempty"`
+	Status      string   `xml:"status,attr,omitempty"`
+}
+
+// FabricSanCloud represents the SAN cloud, which contains the VSANs
+// available on both fabric interconnects.
+type FabricSanCloud struct {
+	XMLName     xml.Name      `xml:"fabricSanCloud"`
+	ChildAction string        `xml:"childAction,attr,omitempty"`
+	Dn          string        `xml:"dn,attr,omitempty"`
+	Mode        string        `xml:"mode,attr,omitempty"`
+	Rn          string        `xml:"rn,attr,omitempty"`
+	Status      string        `xml:"status,attr,omitempty"`
+	FcSans      []FabricFcSan `xml:"fabricFcSan"`
+	Vsans       []FabricVsan  `xml:"fabricVsan"`
+}
+
+// FabricFcSan represents the SAN cloud of a single fabric interconnect, which
+// contains the VSANs and the FC port channels of this fabric interconnect.
+type FabricFcSan struct {
+	XMLName        xml.Name        `xml:"fabricFcSan"`
+	ChildAction    string          `xml:"childAction,attr,omitempty"`
+	Dn             string          `xml:"dn,attr,omitempty"`
+	Id             string          `xml:"id,attr,omitempty"`
+	Locale         string          `xml:"locale,attr,omitempty"`
+	Name           string          `xml:"name,attr,omitempty"`
+	Rn             string          `xml:"rn,attr,omitempty"`
+	Status         string          `xml:"status,attr,omitempty"`
+	Transport      string          `xml:"transport,attr,omitempty"`
+	Type           string          `xml:"type,attr,omitempty"`
+	UplinkTrunking string          `xml:"uplinkTrunking,attr,omitempty"`
+	Vsans          []FabricVsan    `xml:"fabricVsan"`
+	PortChannels   []FabricFcSanPc `xml:"fabricFcSanPc"`
+}
+
+// FabricVsan represents a VSAN.
+type FabricVsan struct {
+	XMLName          xml.Name `xml:"fabricVsan"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	DefaultZoning    string   `xml:"defaultZoning,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	FcoeVlan         int      `xml:"fcoeVlan,attr,omitempty"`
+	FltAggr          int      `xml:"fltAggr,attr,omitempty"`
+	Global           int      `xml:"global,attr,omitempty"`
+	Id               int      `xml:"id,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	Local            int      `xml:"local,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PolicyOwner      string   `xml:"policyOwner,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	Status           string   `xml:"status,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+	ZoningState      string   `xml:"zoningState,attr,omitempty"`
+}
+
+// FabricFcSanPc represents an FC uplink port channel of a fabric interconnect.
+type FabricFcSanPc struct {
+	XMLName          xml.Name `xml:"fabricFcSanPc"`
+	AdminSpeed       string   `xml:"adminSpeed,attr,omitempty"`
+	AdminState       string   `xml:"adminState,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Description      string   `xml:"descr,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	FltAggr          int      `xml:"fltAggr,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalSpeed string   `xml:"operSpeed,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PortId           int      `xml:"portId,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	StateQualifier   string   `xml:"stateQual,attr,omitempty"`
+	Status           string   `xml:"status,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+}
+
+// FcPIo represents a physical FC port of a fabric interconnect.
+type FcPIo struct {
+	XMLName          xml.Name `xml:"fcPIo"`
+	AdminState       string   `xml:"adminState,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	FltAggr          int      `xml:"fltAggr,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	LicenseGP        int      `xml:"licGP,attr,omitempty"`
+	LicenseState     string   `xml:"licState,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	Mode             string   `xml:"mode,attr,omitempty"`
+	Model            string   `xml:"model,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalSpeed string   `xml:"operSpeed,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PortId           int      `xml:"portId,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	Serial           string   `xml:"serial,attr,omitempty"`
+	SlotId           int      `xml:"slotId,attr,omitempty"`
+	StateQualifier   string   `xml:"stateQual,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+	Vendor           string   `xml:"vendor,attr,omitempty"`
+	Wwn              string   `xml:"wwn,attr,omitempty"`
+	XcvrType         string   `xml:"xcvrType,attr,omitempty"`
+}
+
+// Orders in which identities are assigned from a pool.
+const (
+	AssignmentOrderDefault    = "default"
+	AssignmentOrderSequential = "sequential"
+)
+
+// MacpoolPool represents a pool of MAC addresses, which are assigned to vNICs.
+type MacpoolPool struct {
+	XMLName         xml.Name        `xml:"macpoolPool"`
+	Assigned        int             `xml:"assigned,attr,omitempty"`
+	AssignmentOrder string          `xml:"assignmentOrder,attr,omitempty"`
+	ChildAction     string          `xml:"childAction,attr,omitempty"`
+	Description     string          `xml:"descr,attr,omitempty"`
+	Dn              string          `xml:"dn,attr,omitempty"`
+	IntId           string          `xml:"intId,attr,omitempty"`
+	Name            string          `xml:"name,attr,omitempty"`
+	PolicyLevel     int             `xml:"policyLevel,attr,omitempty"`
+	PolicyOwner     string          `xml:"policyOwner,attr,omitempty"`
+	Rn              string          `xml:"rn,attr,omitempty"`
+	Size            int             `xml:"size,attr,omitempty"`
+	Status          string          `xml:"status,attr,omitempty"`
+	Blocks          []MacpoolBlock  `xml:"macpoolBlock"`
+	Pooled          []MacpoolPooled `xml:"macpoolPooled"`
+}
+
+// MacpoolBlock represents a block of MAC addresses within a MAC pool.
+type MacpoolBlock struct {
+	XMLName     xml.Name `xml:"macpoolBlock"`
+	ChildAction string   `xml:"childAction,attr,omitempty"`
+	Dn          string   `xml:"dn,attr,omitempty"`
+	From        string   `xml:"from,attr,omitempty"`
+	Rn          string   `xml:"rn,attr,omitempty"`
+	Status      string   `xml:"status,attr,omitempty"`
+	To          string   `xml:"to,attr,omitempty"`
+}
+
+// MacpoolPooled represents a single MAC address of a MAC pool.
+type MacpoolPooled struct {
+	XMLName          xml.Name `xml:"macpoolPooled"`
+	Assigned         string   `xml:"assigned,attr,omitempty"`
+	AssignedToDn     string   `xml:"assignedToDn,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	Id               string   `xml:"id,attr,omitempty"`
+	Owner            string   `xml:"owner,attr,omitempty"`
+	PoolableDn       string   `xml:"poolableDn,attr,omitempty"`
+	PrevAssignedToDn string   `xml:"prevAssignedToDn,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+}
+
+// UuidpoolPool represents a pool of UUID suffixes, which are assigned to service profiles.
+type UuidpoolPool struct {
+	XMLName         xml.Name         `xml:"uuidpoolPool"`
+	Assigned        int              `xml:"assigned,attr,omitempty"`
+	AssignmentOrder string           `xml:"assignmentOrder,attr,omitempty"`
+	ChildAction     string           `xml:"childAction,attr,omitempty"`
+	Description     string           `xml:"descr,attr,omitempty"`
+	Dn              string           `xml:"dn,attr,omitempty"`
+	IntId           string           `xml:"intId,attr,omitempty"`
+	Name            string           `xml:"name,attr,omitempty"`
+	PolicyLevel     int              `xml:"policyLevel,attr,omitempty"`
+	PolicyOwner     string           `xml:"policyOwner,attr,omitempty"`
+	Prefix          string           `xml:"prefix,attr,omitempty"`
+	Rn              string           `xml:"rn,attr,omitempty"`
+	Size            int              `xml:"size,attr,omitempty"`
+	Status          string           `xml:"status,attr,omitempty"`
+	Blocks          []UuidpoolBlock  `xml:"uuidpoolBlock"`
+	Pooled          []UuidpoolPooled `xml:"uuidpoolPooled"`
+}
+
+// UuidpoolBlock represents a block of UUID suffixes within a UUID pool.
+type UuidpoolBlock struct {
+	XMLName     xml.Name `xml:"uuidpoolBlock"`
+	ChildAction string   `xml:"childAction,attr,omitempty"`
+	Dn          string   `xml:"dn,attr,omitempty"`
+	From        string   `xml:"from,attr,omitempty"`
+	Rn          string   `xml:"rn,attr,omitempty"`
+	Status      string   `xml:"status,attr,omitempty"`
+	To          string   `xml:"to,attr,omitempty"`
+}
+
+// UuidpoolPooled represents a single UUID suffix of a UUID pool.
+type UuidpoolPooled struct {
+	XMLName          xml.Name `xml:"uuidpoolPooled"`
+	Assigned         string   `xml:"assigned,attr,omitempty"`
+	AssignedToDn     string   `xml:"assignedToDn,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	Id               string   `xml:"id,attr,omitempty"`
+	Owner            string   `xml:"owner,attr,omitempty"`
+	PoolableDn       string   `xml:"poolableDn,attr,omitempty"`
+	PrevAssignedToDn string   `xml:"prevAssignedToDn,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+}
+
+// Purposes of a WWN pool.
+const (
+	WwnPurposeNode = "node-wwn-assignment"
+	WwnPurposePort = "port-wwn-assignment"
+)
+
+// FcpoolInitiators represents a pool of WWNNs or WWPNs, which are assigned to service profiles and vHBAs.
+type FcpoolInitiators struct {
+	XMLName         xml.Name            `xml:"fcpoolInitiators"`
+	Assigned        int                 `xml:"assigned,attr,omitempty"`
+	AssignmentOrder string              `xml:"assignmentOrder,attr,omitempty"`
+	ChildAction     string              `xml:"childAction,attr,omitempty"`
+	Description     string              `xml:"descr,attr,omitempty"`
+	Dn              string              `xml:"dn,attr,omitempty"`
+	IntId           string              `xml:"intId,attr,omitempty"`
+	Name            string              `xml:"name,attr,omitempty"`
+	PolicyLevel     int                 `xml:"policyLevel,attr,omitempty"`
+	PolicyOwner     string              `xml:"policyOwner,attr,omitempty"`
+	Purpose         string              `xml:"purpose,attr,omitempty"`
+	Rn              string              `xml:"rn,attr,omitempty"`
+	Size            int                 `xml:"size,attr,omitempty"`
+	Status          string              `xml:"status,attr,omitempty"`
+	Blocks          []FcpoolBlock       `xml:"fcpoolBlock"`
+	Pooled          []FcpoolInitiatorEp `xml:"fcpoolInitiatorEp"`
+}
+
+// FcpoolBlock represents a block of WWNs within a WWN pool.
+type FcpoolBlock struct {
+	XMLName     xml.Name `xml:"fcpoolBlock"`
+	ChildAction string   `xml:"childAction,attr,omitempty"`
+	Dn          string   `xml:"dn,attr,omitempty"`
+	From        string   `xml:"from,attr,omitempty"`
+	Rn          string   `xml:"rn,attr,omitempty"`
+	Status      string   `xml:"status,attr,omitempty"`
+	To          string   `xml:"to,attr,omitempty"`
+}
+
+// FcpoolInitiatorEp represents a single WWN of a WWN pool.
+type FcpoolInitiatorEp struct {
+	XMLName          xml.Name `xml:"fcpoolInitiatorEp"`
+	Assigned         string   `xml:"assigned,attr,omitempty"`
+	AssignedToDn     string   `xml:"assignedToDn,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	Id               string   `xml:"id,attr,omitempty"`
+	Owner            string   `xml:"owner,attr,omitempty"`
+	PoolableDn       string   `xml:"poolableDn,attr,omitempty"`
+	PrevAssignedToDn string   `xml:"prevAssignedToDn,attr,omitempty"`
+	Purpose          string   `xml:"purpose,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+}
+
+// IppoolPool represents a pool of IP addresses, which are assigned to the management controllers of servers.
+type IppoolPool struct {
+	XMLName         xml.Name       `xml:"ippoolPool"`
+	Assigned        int            `xml:"assigned,attr,omitempty"`
+	AssignmentOrder string         `xml:"assignmentOrder,attr,omitempty"`
+	ChildAction     string         `xml:"childAction,attr,omitempty"`
+	Description     string         `xml:"descr,attr,omitempty"`
+	Dn              string         `xml:"dn,attr,omitempty"`
+	IntId           string         `xml:"intId,attr,omitempty"`
+	Name            string         `xml:"name,attr,omitempty"`
+	PolicyLevel     int            `xml:"policyLevel,attr,omitempty"`
+	PolicyOwner     string         `xml:"policyOwner,attr,omitempty"`
+	SupportsDHCP    string         `xml:"supportsDHCP,attr,omitempty"`
+	Rn              string         `xml:"rn,attr,omitempty"`
+	Size            int            `xml:"size,attr,omitempty"`
+	Status          string         `xml:"status,attr,omitempty"`
+	Blocks          []IppoolBlock  `xml:"ippoolBlock"`
+	Pooled          []IppoolPooled `xml:"ippoolPooled"`
+}
+
+// IppoolBlock represents a block of IP addresses within an IP pool.
+type IppoolBlock struct {
+	XMLName        xml.Name `xml:"ippoolBlock"`
+	ChildAction    string   `xml:"childAction,attr,omitempty"`
+	DefaultGateway net.IP   `xml:"defGw,attr,omitempty"`
+	Dn             string   `xml:"dn,attr,omitempty"`
+	From           net.IP   `xml:"from,attr,omitempty"`
+	PrimaryDns     net.IP   `xml:"primDns,attr,omitempty"`
+	Rn             string   `xml:"rn,attr,omitempty"`
+	SecondaryDns   net.IP   `xml:"secDns,attr,omitempty"`
+	Status         string   `xml:"status,attr,omitempty"`
+	Subnet         net.IP   `xml:"subnet,attr,omitempty"`
+	To             net.IP   `xml:"to,attr,omitempty"`
+}
+
+// IppoolPooled represents a single IP address of an IP pool.
+type IppoolPooled struct {
+	XMLName          xml.Name `xml:"ippoolPooled"`
+	Assigned         string   `xml:"assigned,attr,omitempty"`
+	AssignedToDn     string   `xml:"assignedToDn,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	DefaultGateway   net.IP   `xml:"defGw,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	Id               string   `xml:"id,attr,omitempty"`
+	Owner            string   `xml:"owner,attr,omitempty"`
+	PoolableDn       string   `xml:"poolableDn,attr,omitempty"`
+	PrevAssignedToDn string   `xml:"prevAssignedToDn,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	Subnet           net.IP   `xml:"subnet,attr,omitempty"`
+}
+
+// Roles of a physical port, which are reported by the ifRole attribute.
+// The role of unconfigured ports is unknown.
+const (
+	InterfaceRoleServer  = "server"
+	InterfaceRoleNetwork = "network"
+	InterfaceRoleUnknown = "unknown"
+)
+
+// EquipmentSwitchCard represents a switch card of a fabric interconnect,
+// i.e. its fixed module or an expansion module.
+type EquipmentSwitchCard struct {
+	XMLName          xml.Name    `xml:"equipmentSwitchCard"`
+	ChildAction      string      `xml:"childAction,attr,omitempty"`
+	Description      string      `xml:"descr,attr,omitempty"`
+	Dn               string      `xml:"dn,attr,omitempty"`
+	Id               int         `xml:"id,attr,omitempty"`
+	Model            string      `xml:"model,attr,omitempty"`
+	NumPorts         int         `xml:"numPorts,attr,omitempty"`
+	OperationalState string      `xml:"operState,attr,omitempty"`
+	Operability      string      `xml:"operability,attr,omitempty"`
+	Power            string      `xml:"power,attr,omitempty"`
+	Presence         string      `xml:"presence,attr,omitempty"`
+	Revision         string      `xml:"revision,attr,omitempty"`
+	Rn               string      `xml:"rn,attr,omitempty"`
+	Serial           string      `xml:"serial,attr,omitempty"`
+	State            string      `xml:"state,attr,omitempty"`
+	Thermal          string      `xml:"thermal,attr,omitempty"`
+	Vendor           string      `xml:"vendor,attr,omitempty"`
+	Voltage          string      `xml:"voltage,attr,omitempty"`
+	PortGroups       []PortGroup `xml:"portGroup"`
+}
+
+// PortGroup represents a group of ports of a switch card or an I/O module,
+// e.g. the Ethernet ports of a switch card or the backplane ports of an I/O module.
+type PortGroup struct {
+	XMLName        xml.Name            `xml:"portGroup"`
+	ChildAction    string              `xml:"childAction,attr,omitempty"`
+	Dn             string              `xml:"dn,attr,omitempty"`
+	Name           string              `xml:"name,attr,omitempty"`
+	Rn             string              `xml:"rn,attr,omitempty"`
+	Transport      string              `xml:"transport,attr,omitempty"`
+	Type           string              `xml:"type,attr,omitempty"`
+	EtherPorts     []EtherPIo          `xml:"etherPIo"`
+	FcPorts        []FcPIo             `xml:"fcPIo"`
+	BackplanePorts []EtherSwitchIntFIo `xml:"etherSwitchIntFIo"`
+	ServerPorts    []EtherServerIntFIo `xml:"etherServerIntFIo"`
+}
+
+// EtherPIo represents a physical Ethernet port of a fabric interconnect.
+type EtherPIo struct {
+	XMLName                xml.Name `xml:"etherPIo"`
+	AdminState             string   `xml:"adminState,attr,omitempty"`
+	AggrPortId             int      `xml:"aggrPortId,attr,omitempty"`
+	ChassisId              string   `xml:"chassisId,attr,omitempty"`
+	ChildAction            string   `xml:"childAction,attr,omitempty"`
+	Dn                     string   `xml:"dn,attr,omitempty"`
+	Encap                  string   `xml:"encap,attr,omitempty"`
+	EpDn                   string   `xml:"epDn,attr,omitempty"`
+	FltAggr                int      `xml:"fltAggr,attr,omitempty"`
+	InterfaceRole          string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType          string   `xml:"ifType,attr,omitempty"`
+	Lc                     string   `xml:"lc,attr,omitempty"`
+	LicenseGP              int      `xml:"licGP,attr,omitempty"`
+	LicenseState           string   `xml:"licState,attr,omitempty"`
+	Locale                 string   `xml:"locale,attr,omitempty"`
+	Mac                    string   `xml:"mac,attr,omitempty"`
+	Mode                   string   `xml:"mode,attr,omitempty"`
+	Model                  string   `xml:"model,attr,omitempty"`
+	Name                   string   `xml:"name,attr,omitempty"`
+	OperationalSpeed       string   `xml:"operSpeed,attr,omitempty"`
+	OperationalState       string   `xml:"operState,attr,omitempty"`
+	OperationalStateReason string   `xml:"operStateReason,attr,omitempty"`
+	PeerChassisId          string   `xml:"peerChassisId,attr,omitempty"`
+	PeerDn                 string   `xml:"peerDn,attr,omitempty"`
+	PeerPortId             int      `xml:"peerPortId,attr,omitempty"`
+	PeerSlotId             int      `xml:"peerSlotId,attr,omitempty"`
+	PortId                 int      `xml:"portId,attr,omitempty"`
+	Revision               string   `xml:"revision,attr,omitempty"`
+	Rn                     string   `xml:"rn,attr,omitempty"`
+	Serial                 string   `xml:"serial,attr,omitempty"`
+	SlotId                 int      `xml:"slotId,attr,omitempty"`
+	StateQualifier         string   `xml:"stateQual,attr,omitempty"`
+	SwitchId               string   `xml:"switchId,attr,omitempty"`
+	Transport              string   `xml:"transport,attr,omitempty"`
+	Type                   string   `xml:"type,attr,omitempty"`
+	UnifiedPort            string   `xml:"unifiedPort,attr,omitempty"`
+	UserLabel              string   `xml:"usrLbl,attr,omitempty"`
+	Vendor                 string   `xml:"vendor,attr,omitempty"`
+	XcvrType               string   `xml:"xcvrType,attr,omitempty"`
+}
+
+// EtherServerIntFIo represents a server facing port of an I/O module.
+type EtherServerIntFIo struct {
+	XMLName          xml.Name `xml:"etherServerIntFIo"`
+	AdminState       string   `xml:"adminState,attr,omitempty"`
+	ChassisId        string   `xml:"chassisId,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Discovery        string   `xml:"discovery,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	FltAggr          int      `xml:"fltAggr,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	Lc               string   `xml:"lc,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	Mac              string   `xml:"mac,attr,omitempty"`
+	Mode             string   `xml:"mode,attr,omitempty"`
+	Model            string   `xml:"model,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerChassisId    string   `xml:"peerChassisId,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PeerPortId       int      `xml:"peerPortId,attr,omitempty"`
+	PeerSlotId       int      `xml:"peerSlotId,attr,omitempty"`
+	PortId           int      `xml:"portId,attr,omitempty"`
+	Revision         string   `xml:"revision,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	Serial           string   `xml:"serial,attr,omitempty"`
+	SlotId           int      `xml:"slotId,attr,omitempty"`
+	StateQualifier   string   `xml:"stateQual,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+	Vendor           string   `xml:"vendor,attr,omitempty"`
+}
+
+// SwEthPort represents the switching configuration of an Ethernet port of a fabric interconnect.
+type SwEthPort struct {
+	XMLName          xml.Name `xml:"swEthPort"`
+	AdminState       string   `xml:"adminState,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	Mode             string   `xml:"mode,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PortId           int      `xml:"portId,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	SlotId           int      `xml:"slotId,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+	UserLabel        string   `xml:"usrLbl,attr,omitempty"`
+}
+
+// FabricDceSwSrvEp represents a fabric interconnect port configured as server port.
+type FabricDceSwSrvEp struct {
+	XMLName          xml.Name `xml:"fabricDceSwSrvEp"`
+	AdminState       string   `xml:"adminState,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	Lc               string   `xml:"lc,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PortId           int      `xml:"portId,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	SlotId           int      `xml:"slotId,attr,omitempty"`
+	Status           string   `xml:"status,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+	UserLabel        string   `xml:"usrLbl,attr,omitempty"`
+}
+
+// FabricEthLanEp represents a fabric interconnect port configured as Ethernet uplink port.
+type FabricEthLanEp struct {
+	XMLName           xml.Name `xml:"fabricEthLanEp"`
+	AdminSpeed        string   `xml:"adminSpeed,attr,omitempty"`
+	AdminState        string   `xml:"adminState,attr,omitempty"`
+	ChildAction       string   `xml:"childAction,attr,omitempty"`
+	Dn                string   `xml:"dn,attr,omitempty"`
+	EpDn              string   `xml:"epDn,attr,omitempty"`
+	FlowControlPolicy string   `xml:"flowCtrlPolicy,attr,omitempty"`
+	InterfaceRole     string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType     string   `xml:"ifType,attr,omitempty"`
+	Lc                string   `xml:"lc,attr,omitempty"`
+	Locale            string   `xml:"locale,attr,omitempty"`
+	Name              string   `xml:"name,attr,omitempty"`
+	OperationalState  string   `xml:"operState,attr,omitempty"`
+	PeerDn            string   `xml:"peerDn,attr,omitempty"`
+	PortId            int      `xml:"portId,attr,omitempty"`
+	Rn                string   `xml:"rn,attr,omitempty"`
+	SlotId            int      `xml:"slotId,attr,omitempty"`
+	Status            string   `xml:"status,attr,omitempty"`
+	SwitchId          string   `xml:"switchId,attr,omitempty"`
+	Transport         string   `xml:"transport,attr,omitempty"`
+	Type              string   `xml:"type,attr,omitempty"`
+	UserLabel         string   `xml:"usrLbl,attr,omitempty"`
+}
+
+// FabricFcSanEp represents a fabric interconnect port configured as FC uplink port.
+type FabricFcSanEp struct {
+	XMLName          xml.Name `xml:"fabricFcSanEp"`
+	AdminState       string   `xml:"adminState,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	FillPattern      string   `xml:"fillPattern,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	Lc               string   `xml:"lc,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PortId           int      `xml:"portId,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	SlotId           int      `xml:"slotId,attr,omitempty"`
+	Status           string   `xml:"status,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+	UserLabel        string   `xml:"usrLbl,attr,omitempty"`
+	Wwn              string   `xml:"wwn,attr,omitempty"`
+}
+
+// FabricEthEstcEp represents a fabric interconnect port configured as appliance port.
+type FabricEthEstcEp struct {
+	XMLName              xml.Name `xml:"fabricEthEstcEp"`
+	AdminSpeed           string   `xml:"adminSpeed,attr,omitempty"`
+	AdminState           string   `xml:"adminState,attr,omitempty"`
+	ChildAction          string   `xml:"childAction,attr,omitempty"`
+	Dn                   string   `xml:"dn,attr,omitempty"`
+	EpDn                 string   `xml:"epDn,attr,omitempty"`
+	FlowControlPolicy    string   `xml:"flowCtrlPolicy,attr,omitempty"`
+	InterfaceRole        string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType        string   `xml:"ifType,attr,omitempty"`
+	Lc                   string   `xml:"lc,attr,omitempty"`
+	Locale               string   `xml:"locale,attr,omitempty"`
+	Name                 string   `xml:"name,attr,omitempty"`
+	NetworkControlPolicy string   `xml:"nwCtrlPolicyName,attr,omitempty"`
+	OperationalState     string   `xml:"operState,attr,omitempty"`
+	PeerDn               string   `xml:"peerDn,attr,omitempty"`
+	PortId               int      `xml:"portId,attr,omitempty"`
+	PortMode             string   `xml:"portMode,attr,omitempty"`
+	Priority             string   `xml:"prio,attr,omitempty"`
+	Rn                   string   `xml:"rn,attr,omitempty"`
+	SlotId               int      `xml:"slotId,attr,omitempty"`
+	Status               string   `xml:"status,attr,omitempty"`
+	SwitchId             string   `xml:"switchId,attr,omitempty"`
+	Transport            string   `xml:"transport,attr,omitempty"`
+	Type                 string   `xml:"type,attr,omitempty"`
+	UserLabel            string   `xml:"usrLbl,attr,omitempty"`
+}
+
+// FabricEthLanPc represents an Ethernet uplink port channel of a fabric interconnect.
+type FabricEthLanPc struct {
+	XMLName           xml.Name           `xml:"fabricEthLanPc"`
+	AdminSpeed        string             `xml:"adminSpeed,attr,omitempty"`
+	AdminState        string             `xml:"adminState,attr,omitempty"`
+	ChildAction       string             `xml:"childAction,attr,omitempty"`
+	Description       string             `xml:"descr,attr,omitempty"`
+	Dn                string             `xml:"dn,attr,omitempty"`
+	EpDn              string             `xml:"epDn,attr,omitempty"`
+	FlowControlPolicy string             `xml:"flowCtrlPolicy,attr,omitempty"`
+	InterfaceRole     string             `xml:"ifRole,attr,omitempty"`
+	InterfaceType     string             `xml:"ifType,attr,omitempty"`
+	LacpPolicyName    string             `xml:"lacpPolicyName,attr,omitempty"`
+	Locale            string             `xml:"locale,attr,omitempty"`
+	Name              string             `xml:"name,attr,omitempty"`
+	OperationalSpeed  string             `xml:"operSpeed,attr,omitempty"`
+	OperationalState  string             `xml:"operState,attr,omitempty"`
+	PeerDn            string             `xml:"peerDn,attr,omitempty"`
+	PortId            int                `xml:"portId,attr,omitempty"`
+	Rn                string             `xml:"rn,attr,omitempty"`
+	Status            string             `xml:"status,attr,omitempty"`
+	SwitchId          string             `xml:"switchId,attr,omitempty"`
+	Transport         string             `xml:"transport,attr,omitempty"`
+	Type              string             `xml:"type,attr,omitempty"`
+	Members           []FabricEthLanPcEp `xml:"fabricEthLanPcEp"`
+}
+
+// FabricEthLanPcEp represents a member port of an Ethernet uplink port channel.
+type FabricEthLanPcEp struct {
+	XMLName          xml.Name `xml:"fabricEthLanPcEp"`
+	AdminState       string   `xml:"adminState,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PortId           int      `xml:"portId,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	SlotId           int      `xml:"slotId,attr,omitempty"`
+	Status           string   `xml:"status,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+}
+
+// EquipmentPsu represents a power supply unit of a chassis or a fabric interconnect.
+type EquipmentPsu struct {
+	XMLName                    xml.Name                `xml:"equipmentPsu"`
+	ChildAction                string                  `xml:"childAction,attr,omitempty"`
+	Dn                         string                  `xml:"dn,attr,omitempty"`
+	FltAggr                    int                     `xml:"fltAggr,attr,omitempty"`
+	Id                         int                     `xml:"id,attr,omitempty"`
+	ManufacturingTime          string                  `xml:"mfgTime,attr,omitempty"`
+	Model                      string                  `xml:"model,attr,omitempty"`
+	OperationalQualifierReason string                  `xml:"operQualifierReason,attr,omitempty"`
+	OperationalState           string                  `xml:"operState,attr,omitempty"`
+	Operability                string                  `xml:"operability,attr,omitempty"`
+	PartNumber                 string                  `xml:"partNumber,attr,omitempty"`
+	Perf                       string                  `xml:"perf,attr,omitempty"`
+	Power                      string                  `xml:"power,attr,omitempty"`
+	Presence                   string                  `xml:"presence,attr,omitempty"`
+	Revision                   string                  `xml:"revision,attr,omitempty"`
+	Rn                         string                  `xml:"rn,attr,omitempty"`
+	Serial                     string                  `xml:"serial,attr,omitempty"`
+	Thermal                    string                  `xml:"thermal,attr,omitempty"`
+	Vendor                     string                  `xml:"vendor,attr,omitempty"`
+	Vid                        string                  `xml:"vid,attr,omitempty"`
+	Voltage                    string                  `xml:"voltage,attr,omitempty"`
+	InputStats                 *EquipmentPsuInputStats `xml:"equipmentPsuInputStats,omitempty"`
+}
+
+// EquipmentPsuInputStats represents the input statistics of a power supply unit.
+type EquipmentPsuInputStats struct {
+	XMLName       xml.Name `xml:"equipmentPsuInputStats"`
+	ChildAction   string   `xml:"childAction,attr,omitempty"`
+	Current       float64  `xml:"current,attr,omitempty"`
+	CurrentAvg    float64  `xml:"currentAvg,attr,omitempty"`
+	CurrentMax    float64  `xml:"currentMax,attr,omitempty"`
+	CurrentMin    float64  `xml:"currentMin,attr,omitempty"`
+	Dn            string   `xml:"dn,attr,omitempty"`
+	Intervals     int      `xml:"intervals,attr,omitempty"`
+	Power         float64  `xml:"power,attr,omitempty"`
+	PowerAvg      float64  `xml:"powerAvg,attr,omitempty"`
+	PowerMax      float64  `xml:"powerMax,attr,omitempty"`
+	PowerMin      float64  `xml:"powerMin,attr,omitempty"`
+	Rn            string   `xml:"rn,attr,omitempty"`
+	Suspect       string   `xml:"suspect,attr,omitempty"`
+	Thresholded   string   `xml:"thresholded,attr,omitempty"`
+	TimeCollected string   `xml:"timeCollected,attr,omitempty"`
+	Update        int      `xml:"update,attr,omitempty"`
+	Voltage       float64  `xml:"voltage,attr,omitempty"`
+	VoltageAvg    float64  `xml:"voltageAvg,attr,omitempty"`
+	VoltageMax    float64  `xml:"voltageMax,attr,omitempty"`
+	VoltageMin    float64  `xml:"voltageMin,attr,omitempty"`
+}
+
+// EquipmentIOCard represents an I/O module of a chassis, which connects
+// the blades in the chassis to a fabric interconnect.
+type EquipmentIOCard struct {
+	FiniteStateMachineTask
+	XMLName                    xml.Name    `xml:"equipmentIOCard"`
+	AdminPowerState            string      `xml:"adminPowerState,attr,omitempty"`
+	ChassisId                  string      `xml:"chassisId,attr,omitempty"`
+	ChildAction                string      `xml:"childAction,attr,omitempty"`
+	ConfigState                string      `xml:"configState,attr,omitempty"`
+	Discovery                  string      `xml:"discovery,attr,omitempty"`
+	Dn                         string      `xml:"dn,attr,omitempty"`
+	FltAggr                    int         `xml:"fltAggr,attr,omitempty"`
+	Id                         int         `xml:"id,attr,omitempty"`
+	ManufacturingTime          string      `xml:"mfgTime,attr,omitempty"`
+	Model                      string      `xml:"model,attr,omitempty"`
+	OperationalQualifier       string      `xml:"operQualifier,attr,omitempty"`
+	OperationalQualifierReason string      `xml:"operQualifierReason,attr,omitempty"`
+	OperationalState           string      `xml:"operState,attr,omitempty"`
+	Operability                string      `xml:"operability,attr,omitempty"`
+	PartNumber                 string      `xml:"partNumber,attr,omitempty"`
+	PeerCommStatus             string      `xml:"peerCommStatus,attr,omitempty"`
+	Perf                       string      `xml:"perf,attr,omitempty"`
+	Power                      string      `xml:"power,attr,omitempty"`
+	Presence                   string      `xml:"presence,attr,omitempty"`
+	Revision                   string      `xml:"revision,attr,omitempty"`
+	Rn                         string      `xml:"rn,attr,omitempty"`
+	Serial                     string      `xml:"serial,attr,omitempty"`
+	Side                       string      `xml:"side,attr,omitempty"`
+	SwitchId                   string      `xml:"switchId,attr,omitempty"`
+	Thermal                    string      `xml:"thermal,attr,omitempty"`
+	Vendor                     string      `xml:"vendor,attr,omitempty"`
+	Vid                        string      `xml:"vid,attr,omitempty"`
+	Voltage                    string      `xml:"voltage,attr,omitempty"`
+	PortGroups                 []PortGroup `xml:"portGroup"`
+}
+
+// EtherSwitchIntFIo represents a backplane port of an I/O module,
+// which connects the I/O module to a fabric interconnect.
+type EtherSwitchIntFIo struct {
+	XMLName          xml.Name `xml:"etherSwitchIntFIo"`
+	AdminState       string   `xml:"adminState,attr,omitempty"`
+	AggrPortId       int      `xml:"aggrPortId,attr,omitempty"`
+	ChassisId        string   `xml:"chassisId,attr,omitempty"`
+	ChildAction      string   `xml:"childAction,attr,omitempty"`
+	Discovery        string   `xml:"discovery,attr,omitempty"`
+	Dn               string   `xml:"dn,attr,omitempty"`
+	EpDn             string   `xml:"epDn,attr,omitempty"`
+	FltAggr          int      `xml:"fltAggr,attr,omitempty"`
+	InterfaceRole    string   `xml:"ifRole,attr,omitempty"`
+	InterfaceType    string   `xml:"ifType,attr,omitempty"`
+	Lc               string   `xml:"lc,attr,omitempty"`
+	Locale           string   `xml:"locale,attr,omitempty"`
+	Mac              string   `xml:"mac,attr,omitempty"`
+	Mode             string   `xml:"mode,attr,omitempty"`
+	Model            string   `xml:"model,attr,omitempty"`
+	Name             string   `xml:"name,attr,omitempty"`
+	OperationalState string   `xml:"operState,attr,omitempty"`
+	PeerChassisId    string   `xml:"peerChassisId,attr,omitempty"`
+	PeerDn           string   `xml:"peerDn,attr,omitempty"`
+	PeerPortId       int      `xml:"peerPortId,attr,omitempty"`
+	PeerSlotId       int      `xml:"peerSlotId,attr,omitempty"`
+	PortId           int      `xml:"portId,attr,omitempty"`
+	Revision         string   `xml:"revision,attr,omitempty"`
+	Rn               string   `xml:"rn,attr,omitempty"`
+	Serial           string   `xml:"serial,attr,omitempty"`
+	SlotId           int      `xml:"slotId,attr,omitempty"`
+	StateQualifier   string   `xml:"stateQual,attr,omitempty"`
+	SwitchId         string   `xml:"switchId,attr,omitempty"`
+	Transport        string   `xml:"transport,attr,omitempty"`
+	Type             string   `xml:"type,attr,omitempty"`
+	Vendor           string   `xml:"vendor,attr,omitempty"`
+}
+
+// StorageLocalDisk represents a local disk of a storage controller.
+type StorageLocalDisk struct {
+	XMLName                    xml.Name `xml:"storageLocalDisk"`
+	AdminAction                string   `xml:"adminAction,attr,omitempty"`
+	AdminActionTrigger         string   `xml:"adminActionTrigger,attr,omitempty"`
+	BlockSize                  string   `xml:"blockSize,attr,omitempty"`
+	Bootable                   string   `xml:"bootable,attr,omitempty"`
+	ChildAction                string   `xml:"childAction,attr,omitempty"`
+	ConnectionProtocol         string   `xml:"connectionProtocol,attr,omitempty"`
+	DeviceType                 string   `xml:"deviceType,attr,omitempty"`
+	DiskState                  string   `xml:"diskState,attr,omitempty"`
+	Dn                         string   `xml:"dn,attr,omitempty"`
+	Id                         int      `xml:"id,attr,omitempty"`
+	LinkSpeed                  string   `xml:"linkSpeed,attr,omitempty"`
+	LocationDn                 string   `xml:"locationDn,attr,omitempty"`
+	Model                      string   `xml:"model,attr,omitempty"`
+	NumberOfBlocks             string   `xml:"numberOfBlocks,attr,omitempty"`
+	OperationalQualifierReason string   `xml:"operQualifierReason,attr,omitempty"`
+	OperationalState           string   `xml:"operState,attr,omitempty"`
+	Operability                string   `xml:"operability,attr,omitempty"`
+	PhysicalBlockSize          string   `xml:"physicalBlockSize,attr,omitempty"`
+	PowerState                 string   `xml:"powerState,attr,omitempty"`
+	PredictiveFailureCount     int      `xml:"predictiveFailureCount,attr,omitempty"`
+	Presence                   string   `xml:"presence,attr,omitempty"`
+	RawSize                    string   `xml:"rawSize,attr,omitempty"`
+	Revision                   string   `xml:"revision,attr,omitempty"`
+	Rn                         string   `xml:"rn,attr,omitempty"`
+	Serial                     string   `xml:"serial,attr,omitempty"`
+	Size                       string   `xml:"size,attr,omitempty"`
+	Thermal                    string   `xml:"thermal,attr,omitempty"`
+	Vendor                     string   `xml:"vendor,attr,omitempty"`
+	VariantType                string   `xml:"variantType,attr,omitempty"`
+}
+
+// StorageVirtualDrive represents a virtual drive, e.g. a RAID volume, of a storage controller.
+type StorageVirtualDrive struct {
+	XMLName                    xml.Name `xml:"storageVirtualDrive"`
+	AccessPolicy               string   `xml:"accessPolicy,attr,omitempty"`
+	ActualWriteCachePolicy     string   `xml:"actualWriteCachePolicy,attr,omitempty"`
+	AdminAction                string   `xml:"adminAction,attr,omitempty"`
+	AdminActionTrigger         string   `xml:"adminActionTrigger,attr,omitempty"`
+	AvailableSize              string   `xml:"availableSize,attr,omitempty"`
+	BlockSize                  string   `xml:"blockSize,attr,omitempty"`
+	Bootable                   string   `xml:"bootable,attr,omitempty"`
+	ChildAction                string   `xml:"childAction,attr,omitempty"`
+	ConfigState                string   `xml:"configState,attr,omitempty"`
+	ConfiguredWriteCachePolicy string   `xml:"configuredWriteCachePolicy,attr,omitempty"`
+	DeployAction               string   `xml:"deployAction,attr,omitempty"`
+	DriveCache                 string   `xml:"driveCache,attr,omitempty"`
+	DriveState                 string   `xml:"driveState,attr,omitempty"`
+	Dn                         string   `xml:"dn,attr,omitempty"`
+	Id                         int      `xml:"id,attr,omitempty"`
+	IoPolicy                   string   `xml:"ioPolicy,attr,omitempty"`
+	LocationDn                 string   `xml:"locationDn,attr,omitempty"`
+	LunId                      int      `xml:"lunId,attr,omitempty"`
+	Name                       string   `xml:"name,attr,omitempty"`
+	NumberOfBlocks             string   `xml:"numberOfBlocks,attr,omitempty"`
+	OperationalDeviceId        string   `xml:"operDeviceId,attr,omitempty"`
+	OperationalQualifierReason string   `xml:"operQualifierReason,attr,omitempty"`
+	OperationalState           string   `xml:"operState,attr,omitempty"`
+	Operability                string   `xml:"operability,attr,omitempty"`
+	PhysicalBlockSize          string   `xml:"physicalBlockSize,attr,omitempty"`
+	Presence                   string   `xml:"presence,attr,omitempty"`
+	ReadPolicy                 string   `xml:"readPolicy,attr,omitempty"`
+	Rn                         string   `xml:"rn,attr,omitempty"`
+	SecurityFlags              string   `xml:"securityFlags,attr,omitempty"`
+	Size                       string   `xml:"size,attr,omitempty"`
+	StripSize                  string   `xml:"stripSize,attr,omitempty"`
+	Type                       string   `xml:"type,attr,omitempty"`
+	Uuid                       string   `xml:"uuid,attr,omitempty"`
+	VendorUuid                 string   `xml:"vendorUuid,attr,omitempty"`
+}
+
+// StorageLocalLun represents a logical unit of a storage controller.
+type StorageLocalLun struct {
+	XMLName                    xml.Name `xml:"storageLocalLun"`
+	AdminState                 string   `xml:"adminState,attr,omitempty"`
+	BlockSize                  string   `xml:"blockSize,attr,omitempty"`
+	Bootable                   string   `xml:"bootable,attr,omitempty"`
+	ChildAction                string   `xml:"childAction,attr,omitempty"`
+	Dn                         string   `xml:"dn,attr,omitempty"`
+	Id                         int      `xml:"id,attr,omitempty"`
+	LocationDn                 string   `xml:"locationDn,attr,omitempty"`
+	Model                      string   `xml:"model,attr,omitempty"`
+	Name                       string   `xml:"name,attr,omitempty"`
+	NumberOfBlocks             string   `xml:"numberOfBlocks,attr,omitempty"`
+	OperationalQualifierReason string   `xml:"operQualifierReason,attr,omitempty"`
+	OperationalState           string   `xml:"operState,attr,omitempty"`
+	Operability                string   `xml:"operability,attr,omitempty"`
+	Presence                   string   `xml:"presence,attr,omitempty"`
+	Rn                         string   `xml:"rn,attr,omitempty"`
+	Size                       string   `xml:"size,attr,omitempty"`
+	Vendor                     string   `xml:"vendor,attr,omitempty"`
+}
+
+// StorageRaidBattery represents the battery backup unit of a storage controller.
+type StorageRaidBattery struct {
+	XMLName                    xml.Name `xml:"storageRaidBattery"`
+	AdminAction                string   `xml:"adminAction,attr,omitempty"`
+	AdminActionTrigger         string   `xml:"adminActionTrigger,attr,omitempty"`
+	BatteryType                string   `xml:"batteryType,attr,omitempty"`
+	ChildAction                string   `xml:"childAction,attr,omitempty"`
+	Dn                         string   `xml:"dn,attr,omitempty"`
+	Id                         int      `xml:"id,attr,omitempty"`
+	LearningMode               string   `xml:"learningMode,attr,omitempty"`
+	LocationDn                 string   `xml:"locationDn,attr,omitempty"`
+	Model                      string   `xml:"model,attr,omitempty"`
+	OperationalQualifierReason string   `xml:"operQualifierReason,attr,omitempty"`
+	OperationalState           string   `xml:"operState,attr,omitempty"`
+	Operability                string   `xml:"operability,attr,omitempty"`
+	Perf                       string   `xml:"perf,attr,omitempty"`
+	Power                      string   `xml:"power,attr,omitempty"`
+	Presence                   string   `xml:"presence,attr,omitempty"`
+	Revision                   string   `xml:"revision,attr,omitempty"`
+	Rn                         string   `xml:"rn,attr,omitempty"`
+	Serial                     string   `xml:"serial,attr,omitempty"`
+	Thermal                    string   `xml:"thermal,attr,omitempty"`
+	Vendor                     string   `xml:"vendor,attr,omitempty"`
+	Voltage                    string   `xml:"voltage,attr,omitempty"`
+}
+
+// StorageFlexFlashController represents the FlexFlash controller of a
+// compute board, which manages the SD cards of the server.
+type StorageFlexFlashController struct {
+	XMLName                    xml.Name `xml:"storageFlexFlashController"`
+	AdminAction                string   `xml:"adminAction,attr,omitempty"`
+	CardsManageable            string   `xml:"cardsManageable,attr,omitempty"`
+	ChildAction                string   `xml:"childAction,attr,omitempty"`
+	ControllerHealth           string   `xml:"controllerHealth,attr,omitempty"`
+	ControllerState            string   `xml:"controllerState,attr,omitempty"`
+	Discovery                  string   `xml:"discovery,attr,omitempty"`
+	Dn                         string   `xml:"dn,attr,omitempty"`
+	FlexFlashType              string   `xml:"flexFlashType,attr,omitempty"`
+	HasError                   string   `xml:"hasError,attr,omitempty"`
+	Id                         int      `xml:"id,attr,omitempty"`
+	LocationDn                 string   `xml:"locationDn,attr,omitempty"`
+	Model                      string   `xml:"model,attr,omitempty"`
+	NumberOfFlexFlashCards     int      `xml:"numberOfFlexFlashCards,attr,omitempty"`
+	OperatingMode              string   `xml:"operatingMode,attr,omitempty"`
+	OperationalQualifierReason string   `xml:"operQualifierReason,attr,omitempty"`
+	OperationalState           string   `xml:"operState,attr,omitempty"`
+	Operability                string   `xml:"operability,attr,omitempty"`
+	PartitionCount             int      `xml:"partitionCount,attr,omitempty"`
+	Presence                   string   `xml:"presence,attr,omitempty"`
+	Rn                         string   `xml:"rn,attr,omitempty"`
+	Serial                     string   `xml:"serial,attr,omitempty"`
+	Vendor                     string   `xml:"vendor,attr,omitempty"`
 }